@@ -15,6 +15,10 @@ func isBadRequestError(err error) bool {
 	return strings.Contains(err.Error(), "400")
 }
 
+func isForbiddenError(err error) bool {
+	return strings.Contains(err.Error(), "403")
+}
+
 func shouldRetryError(retryErrors []string) plugin.ErrorPredicateWithContext {
 	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
 
@@ -25,24 +25,70 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			},
 		},
 		TableMap: map[string]*plugin.Table{
-			"jira_advanced_setting": tableAdvancedSetting(ctx),
-			"jira_backlog_issue":    tableBacklogIssue(ctx),
-			"jira_board":            tableBoard(ctx),
-			"jira_component":        tableComponent(ctx),
-			"jira_dashboard":        tableDashboard(ctx),
-			"jira_epic":             tableEpic(ctx),
-			"jira_global_setting":   tableGlobalSetting(ctx),
-			"jira_group":            tableGroup(ctx),
-			"jira_issue":            tableIssue(ctx),
-			"jira_issue_comment":    tableIssueComment(ctx),
-			"jira_issue_type":       tableIssueType(ctx),
-			"jira_issue_worklog":    tableIssueWorklog(ctx),
-			"jira_priority":         tablePriority(ctx),
-			"jira_project":          tableProject(ctx),
-			"jira_project_role":     tableProjectRole(ctx),
-			"jira_sprint":           tableSprint(ctx),
-			"jira_user":             tableUser(ctx),
-			"jira_workflow":         tableWorkflow(ctx),
+			"jira_advanced_setting":                   tableAdvancedSetting(ctx),
+			"jira_application_role":                   tableApplicationRole(ctx),
+			"jira_audit_record":                       tableAuditRecord(ctx),
+			"jira_backlog_issue":                      tableBacklogIssue(ctx),
+			"jira_board":                              tableBoard(ctx),
+			"jira_classification_level":               tableClassificationLevel(ctx),
+			"jira_component":                          tableComponent(ctx),
+			"jira_custom_field_option":                tableCustomFieldOption(ctx),
+			"jira_dashboard":                          tableDashboard(ctx),
+			"jira_dashboard_gadget":                   tableDashboardGadget(ctx),
+			"jira_epic":                               tableEpic(ctx),
+			"jira_field":                              tableField(ctx),
+			"jira_field_configuration":                tableFieldConfiguration(ctx),
+			"jira_field_configuration_item":           tableFieldConfigurationItem(ctx),
+			"jira_field_configuration_scheme":         tableFieldConfigurationScheme(ctx),
+			"jira_field_configuration_scheme_project": tableFieldConfigurationSchemeProject(ctx),
+			"jira_field_context":                      tableFieldContext(ctx),
+			"jira_filter":                             tableFilter(ctx),
+			"jira_global_setting":                     tableGlobalSetting(ctx),
+			"jira_group":                              tableGroup(ctx),
+			"jira_installed_app":                      tableInstalledApp(ctx),
+			"jira_instance_license":                   tableInstanceLicense(ctx),
+			"jira_issue":                              tableIssue(ctx),
+			"jira_issue_comment":                      tableIssueComment(ctx),
+			"jira_issue_dev_summary":                  tableIssueDevSummary(ctx),
+			"jira_issue_field_value":                  tableIssueFieldValue(ctx),
+			"jira_issue_link_type":                    tableIssueLinkType(ctx),
+			"jira_issue_property":                     tableIssueProperty(ctx),
+			"jira_issue_remote_link":                  tableIssueRemoteLink(ctx),
+			"jira_issue_security_level_member":        tableIssueSecurityLevelMember(ctx),
+			"jira_issue_security_scheme":              tableIssueSecurityScheme(ctx),
+			"jira_issue_type":                         tableIssueType(ctx),
+			"jira_issue_type_scheme":                  tableIssueTypeScheme(ctx),
+			"jira_issue_type_screen_scheme":           tableIssueTypeScreenScheme(ctx),
+			"jira_issue_worklog":                      tableIssueWorklog(ctx),
+			"jira_label":                              tableLabel(ctx),
+			"jira_notification_scheme":                tableNotificationScheme(ctx),
+			"jira_permission_grant":                   tablePermissionGrant(ctx),
+			"jira_permission_scheme":                  tablePermissionScheme(ctx),
+			"jira_priority":                           tablePriority(ctx),
+			"jira_priority_scheme":                    tablePriorityScheme(ctx),
+			"jira_project":                            tableProject(ctx),
+			"jira_project_role":                       tableProjectRole(ctx),
+			"jira_project_role_actor":                 tableProjectRoleActor(ctx),
+			"jira_public_exposure":                    tablePublicExposure(ctx),
+			"jira_resolution":                         tableResolution(ctx),
+			"jira_screen":                             tableScreen(ctx),
+			"jira_screen_scheme":                      tableScreenScheme(ctx),
+			"jira_screen_tab_field":                   tableScreenTabField(ctx),
+			"jira_server_info":                        tableServerInfo(ctx),
+			"jira_sprint":                             tableSprint(ctx),
+			"jira_status":                             tableStatus(ctx),
+			"jira_status_category":                    tableStatusCategory(ctx),
+			"jira_user":                               tableUser(ctx),
+			"jira_user_project_permission":            tableUserProjectPermission(ctx),
+			"jira_version":                            tableVersion(ctx),
+			"jira_webhook":                            tableWebhook(ctx),
+			"jira_workflow":                           tableWorkflow(ctx),
+			"jira_workflow_lint":                      tableWorkflowLint(ctx),
+			"jira_workflow_scheme":                    tableWorkflowScheme(ctx),
+			"jira_workflow_scheme_project":            tableWorkflowSchemeProject(ctx),
+			"jira_workflow_status":                    tableWorkflowStatus(ctx),
+			"jira_workflow_transition":                tableWorkflowTransition(ctx),
+			"jira_workflow_transition_rule":           tableWorkflowTransitionRule(ctx),
 		},
 	}
 
@@ -0,0 +1,196 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableApplicationRole(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_application_role",
+		Description: "Application roles, which give users access to Jira products such as Jira Software, with their licensed seats.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("key"),
+			Hydrate:    getApplicationRole,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listApplicationRoles,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "key",
+				Description: "The key of the application role.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The display name of the application role.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "defined",
+				Description: "Deprecated. Whether the application role is defined.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "platform",
+				Description: "Whether the application role is for the Jira platform rather than a product.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "selected_by_default",
+				Description: "Whether new users are given the application role by default.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "has_unlimited_seats",
+				Description: "Whether the application role has an unlimited number of seats.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "number_of_seats",
+				Description: "The maximum number of users that can have the application role.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "remaining_seats",
+				Description: "The number of seats that are not used.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "user_count",
+				Description: "The number of users that have the application role and count against the licence.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "user_count_description",
+				Description: "The type of users being counted against the licence.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+			{
+				Name:        "default_groups",
+				Description: "The groups that are added to the application role when it is given to a user by default.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "groups",
+				Description: "The groups associated with the application role.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "group_details",
+				Description: "The names and IDs of the groups associated with the application role.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listApplicationRoles(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_application_role.listApplicationRoles", "connection_error", err)
+		return nil, err
+	}
+
+	req, err := client.NewRequest("GET", "rest/api/2/applicationrole", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_application_role.listApplicationRoles", "get_request_error", err)
+		return nil, err
+	}
+
+	roles := []ApplicationRole{}
+	_, err = client.Do(req, &roles)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_application_role.listApplicationRoles", "api_error", err)
+		return nil, err
+	}
+
+	for _, role := range roles {
+		d.StreamListItem(ctx, role)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getApplicationRole(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	key := d.EqualsQualString("key")
+
+	// Return nil, if empty
+	if key == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_application_role.getApplicationRole", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/2/applicationrole/%s", url.PathEscape(key))
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_application_role.getApplicationRole", "get_request_error", err)
+		return nil, err
+	}
+
+	role := new(ApplicationRole)
+	_, err = client.Do(req, role)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_application_role.getApplicationRole", "api_error", err)
+		return nil, err
+	}
+
+	return *role, nil
+}
+
+//// Custom Structs
+
+type ApplicationRole struct {
+	Key                  string   `json:"key"`
+	Name                 string   `json:"name"`
+	Defined              bool     `json:"defined"`
+	Platform             bool     `json:"platform"`
+	SelectedByDefault    bool     `json:"selectedByDefault"`
+	HasUnlimitedSeats    bool     `json:"hasUnlimitedSeats"`
+	NumberOfSeats        int64    `json:"numberOfSeats"`
+	RemainingSeats       int64    `json:"remainingSeats"`
+	UserCount            int64    `json:"userCount"`
+	UserCountDescription string   `json:"userCountDescription"`
+	DefaultGroups        []string `json:"defaultGroups"`
+	Groups               []string `json:"groups"`
+	GroupDetails         []struct {
+		Name    string `json:"name"`
+		GroupId string `json:"groupId"`
+	} `json:"groupDetails"`
+}
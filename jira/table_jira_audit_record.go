@@ -0,0 +1,240 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAuditRecord(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_audit_record",
+		Description: "Audit records of administrative changes in Jira, such as changes to permissions, schemes and users.",
+		List: &plugin.ListConfig{
+			Hydrate: listAuditRecords,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "created", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}},
+				{Name: "filter", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the audit record.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "summary",
+				Description: "The summary of the change.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created",
+				Description: "The date and time on which the audit record was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Created").Transform(convertJiraTime),
+			},
+			{
+				Name:        "category",
+				Description: "The category of the audit record, for example user management or permissions.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_source",
+				Description: "The event the audit record originated from.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "author_account_id",
+				Description: "The account ID of the user who made the change.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AuthorAccountId").NullIfZero(),
+			},
+			{
+				Name:        "remote_address",
+				Description: "The IP address of the user who made the change.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the audit record.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "filter",
+				Description: "Text to filter the audit records by. Records are returned if they contain all of the words in any of their fields.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filter"),
+			},
+
+			// JSON fields
+			{
+				Name:        "object_item",
+				Description: "The object that was changed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "associated_items",
+				Description: "The objects associated with the change, such as the project a scheme was changed in.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "changed_values",
+				Description: "The list of values changed in the record event.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Summary"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAuditRecords(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_audit_record.listAuditRecords", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 1000
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 1000 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	params := url.Values{}
+	if d.EqualsQualString("filter") != "" {
+		params.Set("filter", d.EqualsQualString("filter"))
+	}
+
+	// The API only supports inclusive bounds, the exact range is
+	// applied to the returned rows by Steampipe
+	from, to := auditRecordTimeRange(d)
+	if !from.IsZero() {
+		params.Set("from", from.UTC().Format("2006-01-02T15:04:05.000Z"))
+	}
+	if !to.IsZero() {
+		params.Set("to", to.UTC().Format("2006-01-02T15:04:05.000Z"))
+	}
+
+	offset := 0
+	for {
+		params.Set("offset", fmt.Sprint(offset))
+		params.Set("limit", fmt.Sprint(maxResults))
+		apiEndpoint := fmt.Sprintf("rest/api/3/auditing/record?%s", params.Encode())
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_audit_record.listAuditRecords", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListAuditRecordResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_audit_record.listAuditRecords", "api_error", err)
+			return nil, err
+		}
+
+		for _, record := range listResult.Records {
+			d.StreamListItem(ctx, record)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		offset = listResult.Offset + len(listResult.Records)
+		if len(listResult.Records) == 0 || offset >= listResult.Total {
+			return nil, nil
+		}
+	}
+}
+
+//// UTILITY FUNCTIONS
+
+// auditRecordTimeRange returns the narrowest range of creation times that
+// satisfies the quals on the created column. Either end is zero if unbounded.
+func auditRecordTimeRange(d *plugin.QueryData) (time.Time, time.Time) {
+	var from, to time.Time
+
+	if d.Quals["created"] == nil {
+		return from, to
+	}
+
+	for _, q := range d.Quals["created"].Quals {
+		value := q.Value.GetTimestampValue().AsTime()
+		switch q.Operator {
+		case "=":
+			from, to = value, value
+		case ">", ">=":
+			if from.IsZero() || value.After(from) {
+				from = value
+			}
+		case "<", "<=":
+			if to.IsZero() || value.Before(to) {
+				to = value
+			}
+		}
+	}
+
+	return from, to
+}
+
+//// Custom Structs
+
+type ListAuditRecordResult struct {
+	Offset  int           `json:"offset"`
+	Limit   int           `json:"limit"`
+	Total   int           `json:"total"`
+	Records []AuditRecord `json:"records"`
+}
+
+type AuditRecord struct {
+	ID              int64              `json:"id"`
+	Summary         string             `json:"summary"`
+	Created         string             `json:"created"`
+	Category        string             `json:"category"`
+	EventSource     string             `json:"eventSource"`
+	AuthorAccountId string             `json:"authorAccountId"`
+	RemoteAddress   string             `json:"remoteAddress"`
+	Description     string             `json:"description"`
+	ObjectItem      AuditRecordItem    `json:"objectItem"`
+	AssociatedItems []AuditRecordItem  `json:"associatedItems"`
+	ChangedValues   []AuditRecordValue `json:"changedValues"`
+}
+
+type AuditRecordItem struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	TypeName   string `json:"typeName"`
+	ParentId   string `json:"parentId"`
+	ParentName string `json:"parentName"`
+}
+
+type AuditRecordValue struct {
+	FieldName   string `json:"fieldName"`
+	ChangedFrom string `json:"changedFrom"`
+	ChangedTo   string `json:"changedTo"`
+}
@@ -0,0 +1,137 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableClassificationLevel(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_classification_level",
+		Description: "Data classification levels, which label issues and projects with the sensitivity of their data.",
+		List: &plugin.ListConfig{
+			Hydrate: listClassificationLevels,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the classification level.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the classification level.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the classification level. Possible values are PUBLISHED, ARCHIVED and DRAFT.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rank",
+				Description: "The rank of the classification level, where lower ranks are less sensitive.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the classification level.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "guideline",
+				Description: "The guideline on how to handle data of the classification level.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "color",
+				Description: "The color of the classification level.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listClassificationLevels(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Data classification is only available in Jira Cloud
+	cloud, err := isJiraCloud(ctx, d)
+	if err != nil || !cloud {
+		return nil, err
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_classification_level.listClassificationLevels", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := "rest/api/3/classification-levels?orderBy=rank"
+	if d.EqualsQualString("status") != "" {
+		apiEndpoint = fmt.Sprintf("%s&status=%s", apiEndpoint, url.QueryEscape(d.EqualsQualString("status")))
+	}
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_classification_level.listClassificationLevels", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListClassificationLevelResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		// Sites without data classification return not found
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_classification_level.listClassificationLevels", "api_error", err)
+		return nil, err
+	}
+
+	for _, level := range listResult.Classifications {
+		d.StreamListItem(ctx, level)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type ListClassificationLevelResult struct {
+	Classifications []ClassificationLevel `json:"classifications"`
+}
+
+type ClassificationLevel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	Rank        int64  `json:"rank"`
+	Description string `json:"description"`
+	Guideline   string `json:"guideline"`
+	Color       string `json:"color"`
+}
@@ -0,0 +1,162 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableCustomFieldOption(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_custom_field_option",
+		Description: "Options of select list, multi-select and cascading select custom fields.",
+		List: &plugin.ListConfig{
+			Hydrate: listCustomFieldOptions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "field_id", Require: plugin.Required},
+				{Name: "context_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the custom field option.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "field_id",
+				Description: "The ID of the custom field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "context_id",
+				Description: "The ID of the custom field context the option belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value",
+				Description: "The value of the custom field option.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "disabled",
+				Description: "Whether the option is disabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "parent_option_id",
+				Description: "For cascading select fields, the ID of the parent option. Null for top-level options.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OptionID").NullIfZero(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Value"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCustomFieldOptions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	fieldId := d.EqualsQualString("field_id")
+	contextId := d.EqualsQualString("context_id")
+
+	if fieldId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_custom_field_option.listCustomFieldOptions", "connection_error", err)
+		return nil, err
+	}
+
+	contextIds := []string{}
+	if contextId != "" {
+		contextIds = append(contextIds, contextId)
+	} else {
+		fieldContexts, err := getFieldContexts(ctx, client, fieldId)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_custom_field_option.listCustomFieldOptions", "api_error", err)
+			return nil, err
+		}
+		for _, fieldContext := range fieldContexts {
+			contextIds = append(contextIds, fieldContext.ID)
+		}
+	}
+
+	for _, id := range contextIds {
+		last := 0
+		for {
+			apiEndpoint := fmt.Sprintf("rest/api/3/field/%s/context/%s/option?startAt=%d&maxResults=%d", fieldId, id, last, 1000)
+
+			req, err := client.NewRequest("GET", apiEndpoint, nil)
+			if err != nil {
+				plugin.Logger(ctx).Error("jira_custom_field_option.listCustomFieldOptions", "get_request_error", err)
+				return nil, err
+			}
+
+			listResult := new(ListCustomFieldOptionResult)
+			_, err = client.Do(req, listResult)
+			if err != nil {
+				// Fields that do not support options return a bad request error
+				if isNotFoundError(err) || isBadRequestError(err) {
+					break
+				}
+				plugin.Logger(ctx).Error("jira_custom_field_option.listCustomFieldOptions", "api_error", err)
+				return nil, err
+			}
+
+			for _, option := range listResult.Values {
+				d.StreamListItem(ctx, CustomFieldOptionInfo{option, fieldId, id})
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			last = listResult.StartAt + len(listResult.Values)
+			if listResult.IsLast || len(listResult.Values) == 0 {
+				break
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type ListCustomFieldOptionResult struct {
+	MaxResults int                 `json:"maxResults"`
+	StartAt    int                 `json:"startAt"`
+	Total      int                 `json:"total"`
+	IsLast     bool                `json:"isLast"`
+	Values     []CustomFieldOption `json:"values"`
+}
+
+type CustomFieldOption struct {
+	ID       string `json:"id"`
+	Value    string `json:"value"`
+	OptionID string `json:"optionId,omitempty"`
+	Disabled bool   `json:"disabled"`
+}
+
+type CustomFieldOptionInfo struct {
+	CustomFieldOption
+	FieldId   string
+	ContextId string
+}
@@ -0,0 +1,344 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableDashboardGadget(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_dashboard_gadget",
+		Description: "The gadgets on each dashboard, with their configuration and the filters they reference.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listDashboardGadgetDashboards,
+			Hydrate:       listDashboardGadgets,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "dashboard_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "dashboard_id",
+				Description: "The ID of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dashboard_name",
+				Description: "The name of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the gadget.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Gadget.ID"),
+			},
+			{
+				Name:        "module_key",
+				Description: "The module key of the gadget type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Gadget.ModuleKey").NullIfZero(),
+			},
+			{
+				Name:        "uri",
+				Description: "The URI of the gadget type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Gadget.URI").NullIfZero(),
+			},
+			{
+				Name:        "gadget_title",
+				Description: "The title of the gadget.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Gadget.Title"),
+			},
+			{
+				Name:        "color",
+				Description: "The color of the gadget.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Gadget.Color"),
+			},
+			{
+				Name:        "position_row",
+				Description: "The row of the gadget on the dashboard.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Gadget.Position.Row"),
+			},
+			{
+				Name:        "position_column",
+				Description: "The column of the gadget on the dashboard.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Gadget.Position.Column"),
+			},
+			{
+				Name:        "filter_id",
+				Description: "The ID of the saved filter referenced by the configuration of the gadget, if any.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDashboardGadgetProperties,
+				Transform:   transform.FromValue().Transform(dashboardGadgetFilterId),
+			},
+
+			// JSON fields
+			{
+				Name:        "properties",
+				Description: "The configuration properties of the gadget, keyed by property key.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDashboardGadgetProperties,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Gadget.Title"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDashboardGadgets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dashboard := h.Item.(Dashboard)
+
+	if d.EqualsQualString("dashboard_id") != "" && d.EqualsQualString("dashboard_id") != dashboard.Id {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_dashboard_gadget.listDashboardGadgets", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/dashboard/%s/gadget", dashboard.Id)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_dashboard_gadget.listDashboardGadgets", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListDashboardGadgetResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		if isNotFoundError(err) || isForbiddenError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_dashboard_gadget.listDashboardGadgets", "api_error", err)
+		return nil, err
+	}
+
+	for _, gadget := range listResult.Gadgets {
+		d.StreamListItem(ctx, DashboardGadgetInfo{
+			DashboardId:   dashboard.Id,
+			DashboardName: dashboard.Name,
+			Gadget:        gadget,
+		})
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// listDashboardGadgetDashboards lists the dashboards to read the gadgets of,
+// fetching only the requested dashboard when the dashboard_id qual is set
+func listDashboardGadgetDashboards(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	dashboardId := d.EqualsQualString("dashboard_id")
+	if dashboardId == "" {
+		return listDashboards(ctx, d, h)
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_dashboard_gadget.listDashboardGadgetDashboards", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/dashboard/%s", url.PathEscape(dashboardId))
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_dashboard_gadget.listDashboardGadgetDashboards", "get_request_error", err)
+		return nil, err
+	}
+
+	dashboard := new(Dashboard)
+	_, err = client.Do(req, dashboard)
+	if err != nil {
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_dashboard_gadget.listDashboardGadgetDashboards", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, *dashboard)
+	return nil, nil
+}
+
+// getDashboardGadgetProperties returns the properties of a gadget, which hold
+// its configuration, as a map of property keys to values
+func getDashboardGadgetProperties(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	gadget := h.Item.(DashboardGadgetInfo)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_dashboard_gadget.getDashboardGadgetProperties", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/dashboard/%s/items/%d/properties", gadget.DashboardId, gadget.Gadget.ID)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_dashboard_gadget.getDashboardGadgetProperties", "get_request_error", err)
+		return nil, err
+	}
+
+	keys := new(DashboardItemPropertyKeys)
+	_, err = client.Do(req, keys)
+	if err != nil {
+		if isNotFoundError(err) || isForbiddenError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_dashboard_gadget.getDashboardGadgetProperties", "api_error", err)
+		return nil, err
+	}
+
+	properties := map[string]interface{}{}
+	for _, key := range keys.Keys {
+		apiEndpoint := fmt.Sprintf("rest/api/3/dashboard/%s/items/%d/properties/%s", gadget.DashboardId, gadget.Gadget.ID, url.PathEscape(key.Key))
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_dashboard_gadget.getDashboardGadgetProperties", "get_request_error", err)
+			return nil, err
+		}
+
+		property := new(DashboardItemProperty)
+		_, err = client.Do(req, property)
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			plugin.Logger(ctx).Error("jira_dashboard_gadget.getDashboardGadgetProperties", "api_error", err)
+			return nil, err
+		}
+
+		properties[property.Key] = property.Value
+	}
+
+	return properties, nil
+}
+
+//// TRANSFORM FUNCTION
+
+// Gadgets reference filters by ID, either as a plain ID or prefixed with
+// "filter-" when the gadget also accepts projects
+var gadgetFilterIdRegexp = regexp.MustCompile(`^(?:filter-)?(\d+)$`)
+
+// dashboardGadgetFilterId returns the ID of the first filter referenced in
+// the configuration properties of a gadget
+func dashboardGadgetFilterId(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	properties, ok := d.Value.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	var find func(value interface{}) string
+	find = func(value interface{}) string {
+		// Some gadgets keep their configuration in arrays, for example one
+		// entry per chart series
+		if items, ok := value.([]interface{}); ok {
+			for _, item := range items {
+				if id := find(item); id != "" {
+					return id
+				}
+			}
+			return ""
+		}
+
+		item, ok := value.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		for _, key := range []string{"filterId", "projectOrFilterId"} {
+			if id, ok := item[key].(string); ok {
+				if match := gadgetFilterIdRegexp.FindStringSubmatch(id); match != nil {
+					return match[1]
+				}
+			}
+		}
+		// Walk the nested values in key order, so the result is stable
+		nestedKeys := make([]string, 0, len(item))
+		for key := range item {
+			nestedKeys = append(nestedKeys, key)
+		}
+		sort.Strings(nestedKeys)
+		for _, key := range nestedKeys {
+			if id := find(item[key]); id != "" {
+				return id
+			}
+		}
+		return ""
+	}
+
+	if id := find(properties); id != "" {
+		return strconv.ParseInt(id, 10, 64)
+	}
+	return nil, nil
+}
+
+//// Custom Structs
+
+type ListDashboardGadgetResult struct {
+	Gadgets []DashboardGadget `json:"gadgets"`
+}
+
+type DashboardGadget struct {
+	ID        int64  `json:"id"`
+	ModuleKey string `json:"moduleKey"`
+	URI       string `json:"uri"`
+	Title     string `json:"title"`
+	Color     string `json:"color"`
+	Position  struct {
+		Row    int64 `json:"row"`
+		Column int64 `json:"column"`
+	} `json:"position"`
+}
+
+type DashboardGadgetInfo struct {
+	DashboardId   string
+	DashboardName string
+	Gadget        DashboardGadget
+}
+
+type DashboardItemPropertyKeys struct {
+	Keys []struct {
+		Self string `json:"self"`
+		Key  string `json:"key"`
+	} `json:"keys"`
+}
+
+type DashboardItemProperty struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
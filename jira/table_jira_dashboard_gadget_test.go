@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"context"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func TestDashboardGadgetFilterId(t *testing.T) {
+	cases := []struct {
+		name       string
+		properties map[string]interface{}
+		want       interface{}
+	}{
+		{
+			name:       "filter id",
+			properties: map[string]interface{}{"config": map[string]interface{}{"filterId": "filter-10010"}},
+			want:       int64(10010),
+		},
+		{
+			name:       "project or filter id",
+			properties: map[string]interface{}{"config": map[string]interface{}{"projectOrFilterId": "filter-10011"}},
+			want:       int64(10011),
+		},
+		{
+			name: "filter id in an array",
+			properties: map[string]interface{}{
+				"config": map[string]interface{}{
+					"series": []interface{}{
+						map[string]interface{}{"color": "blue"},
+						map[string]interface{}{"filterId": "filter-10012"},
+					},
+				},
+			},
+			want: int64(10012),
+		},
+		{
+			name:       "project",
+			properties: map[string]interface{}{"config": map[string]interface{}{"projectOrFilterId": "project-10000"}},
+		},
+		{
+			name:       "no filter",
+			properties: map[string]interface{}{"config": map[string]interface{}{"isConfigured": true}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := dashboardGadgetFilterId(context.Background(), &transform.TransformData{Value: c.properties})
+			if err != nil {
+				t.Fatalf("dashboardGadgetFilterId() error = %v", err)
+			}
+			if got != c.want {
+				t.Errorf("dashboardGadgetFilterId() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,360 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableField(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_field",
+		Description: "Fields hold the data of an issue. Includes system fields and custom fields, with usage statistics.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getField,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listFields,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Optional},
+				{Name: "is_custom", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the field.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "key",
+				Description: "The key of the field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_custom",
+				Description: "Whether the field is a custom field.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getFieldDetail,
+				Transform:   transform.FromField("Custom"),
+			},
+			{
+				Name:        "schema_type",
+				Description: "The data type of the field, for example string, number, array or user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Schema.Type"),
+			},
+			{
+				Name:        "custom_type",
+				Description: "The custom field type, if the field is a custom field. For example com.atlassian.jira.plugin.system.customfieldtypes:select.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Schema.Custom").NullIfZero(),
+			},
+			{
+				Name:        "searcher_key",
+				Description: "The searcher key of the field, if the field is a custom field.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SearcherKey").NullIfZero(),
+			},
+			{
+				Name:        "is_locked",
+				Description: "Whether the field is locked and cannot be edited.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "searchable",
+				Description: "Whether the field can be used in a search.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getFieldDetail,
+			},
+			{
+				Name:        "navigable",
+				Description: "Whether the field can be used as a column on the issue navigator.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getFieldDetail,
+			},
+			{
+				Name:        "orderable",
+				Description: "Whether the content of the field can be used to order lists.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getFieldDetail,
+			},
+			{
+				Name:        "last_used",
+				Description: "Time when the field was last used. Null if the field has never been used or usage is not tracked.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastUsed.Value").Transform(convertJiraTime),
+			},
+			{
+				Name:        "last_used_type",
+				Description: "Whether the last used time of the field is tracked. Possible values are TRACKED, NOT_TRACKED and NO_INFORMATION.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LastUsed.Type"),
+			},
+			{
+				Name:        "screens_count",
+				Description: "The number of screens where the field is used.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "contexts_count",
+				Description: "The number of contexts where the field is used.",
+				Type:        proto.ColumnType_INT,
+			},
+
+			// JSON fields
+			{
+				Name:        "clause_names",
+				Description: "The names that can be used to reference the field in an advanced search.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFieldDetail,
+			},
+			{
+				Name:        "schema",
+				Description: "The data schema of the field.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listFields(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	query := ""
+	if d.EqualsQualString("name") != "" {
+		query = fmt.Sprintf("%s&query=%s", query, url.QueryEscape(d.EqualsQualString("name")))
+	}
+	if d.EqualsQuals["is_custom"] != nil {
+		if d.EqualsQuals["is_custom"].GetBoolValue() {
+			query = fmt.Sprintf("%s&type=custom", query)
+		} else {
+			query = fmt.Sprintf("%s&type=system", query)
+		}
+	}
+
+	return nil, streamFields(ctx, d, query)
+}
+
+// streamFields pages through the field search API, appending the given query
+// string to each request.
+func streamFields(ctx context.Context, d *plugin.QueryData, query string) error {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field.streamFields", "connection_error", err)
+		return err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 1000
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 1000 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf(
+			"rest/api/3/field/search?expand=key,lastUsed,screensCount,contextsCount,isLocked,searcherKey&startAt=%d&maxResults=%d%s",
+			last,
+			maxResults,
+			query,
+		)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_field.streamFields", "get_request_error", err)
+			return err
+		}
+
+		listResult := new(ListFieldResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_field.streamFields", "api_error", err)
+			return err
+		}
+
+		for _, field := range listResult.Values {
+			d.StreamListItem(ctx, field)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getField(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	fieldId := d.EqualsQualString("id")
+	if fieldId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field.getField", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf(
+		"rest/api/3/field/search?expand=key,lastUsed,screensCount,contextsCount,isLocked,searcherKey&id=%s",
+		url.QueryEscape(fieldId),
+	)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field.getField", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListFieldResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_field.getField", "api_error", err)
+		return nil, err
+	}
+
+	for _, field := range listResult.Values {
+		if field.ID == fieldId {
+			return field, nil
+		}
+	}
+	return nil, nil
+}
+
+func getFieldDetail(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	field := h.Item.(Field)
+
+	details, err := getFieldDetails(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	if detail, ok := details[field.ID]; ok {
+		return detail, nil
+	}
+	return nil, nil
+}
+
+// getFieldDetails retrieves and caches all system and custom fields, keyed by field ID
+func getFieldDetails(ctx context.Context, d *plugin.QueryData) (map[string]FieldDetail, error) {
+	cacheKey := "field_details"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(map[string]FieldDetail), nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field.getFieldDetails", "connection_error", err)
+		return nil, err
+	}
+
+	req, err := client.NewRequest("GET", "rest/api/3/field", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field.getFieldDetails", "get_request_error", err)
+		return nil, err
+	}
+
+	fields := []FieldDetail{}
+	_, err = client.Do(req, &fields)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field.getFieldDetails", "api_error", err)
+		return nil, err
+	}
+
+	details := make(map[string]FieldDetail, len(fields))
+	for _, field := range fields {
+		details[field.ID] = field
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, details)
+	return details, nil
+}
+
+//// Custom Structs
+
+type ListFieldResult struct {
+	MaxResults int     `json:"maxResults"`
+	StartAt    int     `json:"startAt"`
+	Total      int     `json:"total"`
+	IsLast     bool    `json:"isLast"`
+	Values     []Field `json:"values"`
+}
+
+type Field struct {
+	ID            string        `json:"id"`
+	Key           string        `json:"key"`
+	Name          string        `json:"name"`
+	Description   string        `json:"description"`
+	Schema        FieldSchema   `json:"schema"`
+	SearcherKey   string        `json:"searcherKey"`
+	IsLocked      bool          `json:"isLocked"`
+	ScreensCount  int           `json:"screensCount"`
+	ContextsCount int           `json:"contextsCount"`
+	LastUsed      FieldLastUsed `json:"lastUsed"`
+}
+
+type FieldSchema struct {
+	Type     string `json:"type"`
+	Items    string `json:"items,omitempty"`
+	System   string `json:"system,omitempty"`
+	Custom   string `json:"custom,omitempty"`
+	CustomID int64  `json:"customId,omitempty"`
+}
+
+type FieldLastUsed struct {
+	Type  string  `json:"type"`
+	Value *string `json:"value"`
+}
+
+type FieldDetail struct {
+	ID          string      `json:"id"`
+	Key         string      `json:"key"`
+	Name        string      `json:"name"`
+	Custom      bool        `json:"custom"`
+	Orderable   bool        `json:"orderable"`
+	Navigable   bool        `json:"navigable"`
+	Searchable  bool        `json:"searchable"`
+	ClauseNames []string    `json:"clauseNames"`
+	Schema      FieldSchema `json:"schema"`
+}
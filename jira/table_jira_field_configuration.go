@@ -0,0 +1,166 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableFieldConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_field_configuration",
+		Description: "Field configurations define whether fields are required or hidden, and how they are rendered.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getFieldConfiguration,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listFieldConfigurations,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the field configuration.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the field configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the field configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_default",
+				Description: "Whether the field configuration is the default.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listFieldConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_configuration.listFieldConfigurations", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 50
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 50 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/fieldconfiguration?startAt=%d&maxResults=%d", last, maxResults)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_field_configuration.listFieldConfigurations", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListFieldConfigurationResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_field_configuration.listFieldConfigurations", "api_error", err)
+			return nil, err
+		}
+
+		for _, configuration := range listResult.Values {
+			d.StreamListItem(ctx, configuration)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFieldConfiguration(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	configurationId := d.EqualsQuals["id"].GetInt64Value()
+	if configurationId == 0 {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_configuration.getFieldConfiguration", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/fieldconfiguration?id=%d", configurationId)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_configuration.getFieldConfiguration", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListFieldConfigurationResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_field_configuration.getFieldConfiguration", "api_error", err)
+		return nil, err
+	}
+
+	if len(listResult.Values) > 0 {
+		return listResult.Values[0], nil
+	}
+	return nil, nil
+}
+
+//// Custom Structs
+
+type ListFieldConfigurationResult struct {
+	Self       string               `json:"self"`
+	NextPage   string               `json:"nextPage"`
+	MaxResults int                  `json:"maxResults"`
+	StartAt    int                  `json:"startAt"`
+	Total      int                  `json:"total"`
+	IsLast     bool                 `json:"isLast"`
+	Values     []FieldConfiguration `json:"values"`
+}
+
+type FieldConfiguration struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsDefault   bool   `json:"isDefault"`
+}
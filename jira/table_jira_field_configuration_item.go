@@ -0,0 +1,155 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableFieldConfigurationItem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_field_configuration_item",
+		Description: "The settings of each field in each field configuration, such as whether it is required or hidden.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listFieldConfigurations,
+			Hydrate:       listFieldConfigurationItems,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "field_configuration_id", Require: plugin.Optional},
+				{Name: "field_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "field_configuration_id",
+				Description: "The ID of the field configuration.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "field_configuration_name",
+				Description: "The name of the field configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "field_id",
+				Description: "The ID of the field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_required",
+				Description: "Whether the field is required.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_hidden",
+				Description: "Whether the field is hidden.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "renderer",
+				Description: "The renderer type for the field, for example jira-text-renderer or atlassian-wiki-renderer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the field in the field configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listFieldConfigurationItems(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	configuration := h.Item.(FieldConfiguration)
+
+	if d.EqualsQuals["field_configuration_id"] != nil && d.EqualsQuals["field_configuration_id"].GetInt64Value() != configuration.ID {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_configuration_item.listFieldConfigurationItems", "connection_error", err)
+		return nil, err
+	}
+
+	fieldId := d.EqualsQualString("field_id")
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/fieldconfiguration/%d/fields?startAt=%d&maxResults=50", configuration.ID, last)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_field_configuration_item.listFieldConfigurationItems", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListFieldConfigurationItemResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_field_configuration_item.listFieldConfigurationItems", "api_error", err)
+			return nil, err
+		}
+
+		for _, item := range listResult.Values {
+			if fieldId != "" && item.ID != fieldId {
+				continue
+			}
+			d.StreamListItem(ctx, FieldConfigurationItemInfo{
+				FieldConfigurationId:   configuration.ID,
+				FieldConfigurationName: configuration.Name,
+				FieldId:                item.ID,
+				IsRequired:             item.IsRequired,
+				IsHidden:               item.IsHidden,
+				Renderer:               item.Renderer,
+				Description:            item.Description,
+			})
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type ListFieldConfigurationItemResult struct {
+	MaxResults int                      `json:"maxResults"`
+	StartAt    int                      `json:"startAt"`
+	Total      int                      `json:"total"`
+	IsLast     bool                     `json:"isLast"`
+	Values     []FieldConfigurationItem `json:"values"`
+}
+
+type FieldConfigurationItem struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	IsHidden    bool   `json:"isHidden"`
+	IsRequired  bool   `json:"isRequired"`
+	Renderer    string `json:"renderer"`
+}
+
+type FieldConfigurationItemInfo struct {
+	FieldConfigurationId   int64
+	FieldConfigurationName string
+	FieldId                string
+	IsRequired             bool
+	IsHidden               bool
+	Renderer               string
+	Description            string
+}
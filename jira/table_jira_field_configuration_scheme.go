@@ -0,0 +1,225 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableFieldConfigurationScheme(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_field_configuration_scheme",
+		Description: "Field configuration schemes define the field configuration used for each issue type in the projects they are associated with.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getFieldConfigurationScheme,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listFieldConfigurationSchemes,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the field configuration scheme.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the field configuration scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the field configuration scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+			{
+				Name:        "issue_type_mappings",
+				Description: "The issue type to field configuration mappings, where each key is an issue type ID, or default for unmapped issue types, and each value is a field configuration ID.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFieldConfigurationSchemeMappings,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listFieldConfigurationSchemes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_configuration_scheme.listFieldConfigurationSchemes", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 50
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 50 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/fieldconfigurationscheme?startAt=%d&maxResults=%d", last, maxResults)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_field_configuration_scheme.listFieldConfigurationSchemes", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListFieldConfigurationSchemeResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_field_configuration_scheme.listFieldConfigurationSchemes", "api_error", err)
+			return nil, err
+		}
+
+		for _, scheme := range listResult.Values {
+			d.StreamListItem(ctx, scheme)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFieldConfigurationScheme(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	schemeId := d.EqualsQualString("id")
+	if schemeId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_configuration_scheme.getFieldConfigurationScheme", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/fieldconfigurationscheme?id=%s", url.QueryEscape(schemeId))
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_configuration_scheme.getFieldConfigurationScheme", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListFieldConfigurationSchemeResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_field_configuration_scheme.getFieldConfigurationScheme", "api_error", err)
+		return nil, err
+	}
+
+	if len(listResult.Values) > 0 {
+		return listResult.Values[0], nil
+	}
+	return nil, nil
+}
+
+func getFieldConfigurationSchemeMappings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	scheme := h.Item.(FieldConfigurationScheme)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_configuration_scheme.getFieldConfigurationSchemeMappings", "connection_error", err)
+		return nil, err
+	}
+
+	mappings := map[string]string{}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf(
+			"rest/api/3/fieldconfigurationscheme/mapping?fieldConfigurationSchemeId=%s&startAt=%d&maxResults=50",
+			url.QueryEscape(scheme.ID),
+			last,
+		)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_field_configuration_scheme.getFieldConfigurationSchemeMappings", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListFieldConfigurationSchemeMappingResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_field_configuration_scheme.getFieldConfigurationSchemeMappings", "api_error", err)
+			return nil, err
+		}
+
+		for _, mapping := range listResult.Values {
+			mappings[mapping.IssueTypeID] = mapping.FieldConfigurationID
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return mappings, nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type ListFieldConfigurationSchemeResult struct {
+	Self       string                     `json:"self"`
+	NextPage   string                     `json:"nextPage"`
+	MaxResults int                        `json:"maxResults"`
+	StartAt    int                        `json:"startAt"`
+	Total      int                        `json:"total"`
+	IsLast     bool                       `json:"isLast"`
+	Values     []FieldConfigurationScheme `json:"values"`
+}
+
+type FieldConfigurationScheme struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type ListFieldConfigurationSchemeMappingResult struct {
+	MaxResults int  `json:"maxResults"`
+	StartAt    int  `json:"startAt"`
+	Total      int  `json:"total"`
+	IsLast     bool `json:"isLast"`
+	Values     []struct {
+		FieldConfigurationSchemeID string `json:"fieldConfigurationSchemeId"`
+		IssueTypeID                string `json:"issueTypeId"`
+		FieldConfigurationID       string `json:"fieldConfigurationId"`
+	} `json:"values"`
+}
@@ -0,0 +1,122 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableFieldConfigurationSchemeProject(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_field_configuration_scheme_project",
+		Description: "The field configuration scheme associated with each project.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listFieldConfigurationSchemeProjects,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "project_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_key",
+				Description: "The key of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "field_configuration_scheme_id",
+				Description: "The ID of the field configuration scheme associated with the project. Null if the project uses the default field configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FieldConfigurationSchemeId").NullIfZero(),
+			},
+			{
+				Name:        "field_configuration_scheme_name",
+				Description: "The name of the field configuration scheme associated with the project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FieldConfigurationSchemeName").NullIfZero(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listFieldConfigurationSchemeProjects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(Project)
+
+	if d.EqualsQualString("project_id") != "" && d.EqualsQualString("project_id") != project.ID {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_configuration_scheme_project.listFieldConfigurationSchemeProjects", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/fieldconfigurationscheme/project?projectId=%s", project.ID)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_configuration_scheme_project.listFieldConfigurationSchemeProjects", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListFieldConfigurationSchemeProjectResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		// Team-managed projects do not use field configuration schemes
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_field_configuration_scheme_project.listFieldConfigurationSchemeProjects", "api_error", err)
+		return nil, err
+	}
+
+	for _, association := range listResult.Values {
+		item := FieldConfigurationSchemeProject{
+			ProjectId:  project.ID,
+			ProjectKey: project.Key,
+		}
+		// Projects that use the default field configuration have no scheme
+		if association.FieldConfigurationScheme != nil {
+			item.FieldConfigurationSchemeId = association.FieldConfigurationScheme.ID
+			item.FieldConfigurationSchemeName = association.FieldConfigurationScheme.Name
+		}
+
+		d.StreamListItem(ctx, item)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type ListFieldConfigurationSchemeProjectResult struct {
+	Values []struct {
+		ProjectIds               []string                  `json:"projectIds"`
+		FieldConfigurationScheme *FieldConfigurationScheme `json:"fieldConfigurationScheme"`
+	} `json:"values"`
+}
+
+type FieldConfigurationSchemeProject struct {
+	ProjectId                    string
+	ProjectKey                   string
+	FieldConfigurationSchemeId   string
+	FieldConfigurationSchemeName string
+}
@@ -0,0 +1,294 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableFieldContext(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_field_context",
+		Description: "Custom field contexts define the projects and issue types a custom field applies to.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCustomFieldsForContexts,
+			Hydrate:       listFieldContexts,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "field_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the context.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "field_id",
+				Description: "The ID of the custom field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "field_name",
+				Description: "The name of the custom field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the context.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the context.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_global_context",
+				Description: "Whether the context is global, i.e. applies to all projects.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "is_any_issue_type",
+				Description: "Whether the context applies to all issue types.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// JSON fields
+			{
+				Name:        "project_ids",
+				Description: "The IDs of the projects the context applies to. Empty for global contexts.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFieldContextProjectIds,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "issue_type_ids",
+				Description: "The IDs of the issue types the context applies to. Empty if the context applies to any issue type.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getFieldContextIssueTypeIds,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCustomFieldsForContexts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	query := "&type=custom"
+	if d.EqualsQualString("field_id") != "" {
+		query = fmt.Sprintf("%s&id=%s", query, url.QueryEscape(d.EqualsQualString("field_id")))
+	}
+
+	return nil, streamFields(ctx, d, query)
+}
+
+func listFieldContexts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	field := h.Item.(Field)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_context.listFieldContexts", "connection_error", err)
+		return nil, err
+	}
+
+	fieldContexts, err := getFieldContexts(ctx, client, field.ID)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_context.listFieldContexts", "api_error", err)
+		return nil, err
+	}
+
+	for _, fieldContext := range fieldContexts {
+		d.StreamListItem(ctx, FieldContextInfo{fieldContext, field.ID, field.Name})
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getFieldContexts pages through all contexts of a single custom field
+func getFieldContexts(ctx context.Context, client *jira.Client, fieldId string) ([]FieldContext, error) {
+	fieldContexts := []FieldContext{}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/field/%s/context?startAt=%d&maxResults=%d", fieldId, last, 100)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		listResult := new(ListFieldContextResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			// Locked fields and fields of apps may not expose their contexts
+			if isNotFoundError(err) || isBadRequestError(err) || isForbiddenError(err) {
+				return fieldContexts, nil
+			}
+			return nil, err
+		}
+
+		fieldContexts = append(fieldContexts, listResult.Values...)
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return fieldContexts, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFieldContextProjectIds(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	fieldContext := h.Item.(FieldContextInfo)
+	if fieldContext.IsGlobalContext {
+		return []string{}, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_context.getFieldContextProjectIds", "connection_error", err)
+		return nil, err
+	}
+
+	mappings, err := listFieldContextMappings(ctx, client, fieldContext, "projectmapping")
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_context.getFieldContextProjectIds", "api_error", err)
+		return nil, err
+	}
+
+	projectIds := []string{}
+	for _, mapping := range mappings {
+		if mapping.ProjectID != "" {
+			projectIds = append(projectIds, mapping.ProjectID)
+		}
+	}
+	return projectIds, nil
+}
+
+func getFieldContextIssueTypeIds(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	fieldContext := h.Item.(FieldContextInfo)
+	if fieldContext.IsAnyIssueType {
+		return []string{}, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_context.getFieldContextIssueTypeIds", "connection_error", err)
+		return nil, err
+	}
+
+	mappings, err := listFieldContextMappings(ctx, client, fieldContext, "issuetypemapping")
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_field_context.getFieldContextIssueTypeIds", "api_error", err)
+		return nil, err
+	}
+
+	issueTypeIds := []string{}
+	for _, mapping := range mappings {
+		if mapping.IssueTypeID != "" {
+			issueTypeIds = append(issueTypeIds, mapping.IssueTypeID)
+		}
+	}
+	return issueTypeIds, nil
+}
+
+// listFieldContextMappings pages through the project or issue type mappings of a single context
+func listFieldContextMappings(ctx context.Context, client *jira.Client, fieldContext FieldContextInfo, mappingType string) ([]FieldContextMapping, error) {
+	mappings := []FieldContextMapping{}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf(
+			"rest/api/3/field/%s/context/%s?contextId=%s&startAt=%d&maxResults=%d",
+			fieldContext.FieldId,
+			mappingType,
+			fieldContext.ID,
+			last,
+			1000,
+		)
+
+		req, err := client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		listResult := new(ListFieldContextMappingResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			if isNotFoundError(err) {
+				return mappings, nil
+			}
+			return nil, err
+		}
+
+		mappings = append(mappings, listResult.Values...)
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return mappings, nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type ListFieldContextResult struct {
+	MaxResults int            `json:"maxResults"`
+	StartAt    int            `json:"startAt"`
+	Total      int            `json:"total"`
+	IsLast     bool           `json:"isLast"`
+	Values     []FieldContext `json:"values"`
+}
+
+type FieldContext struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	IsGlobalContext bool   `json:"isGlobalContext"`
+	IsAnyIssueType  bool   `json:"isAnyIssueType"`
+}
+
+type FieldContextInfo struct {
+	FieldContext
+	FieldId   string
+	FieldName string
+}
+
+type ListFieldContextMappingResult struct {
+	MaxResults int                   `json:"maxResults"`
+	StartAt    int                   `json:"startAt"`
+	Total      int                   `json:"total"`
+	IsLast     bool                  `json:"isLast"`
+	Values     []FieldContextMapping `json:"values"`
+}
+
+type FieldContextMapping struct {
+	ContextID       string `json:"contextId"`
+	ProjectID       string `json:"projectId,omitempty"`
+	IssueTypeID     string `json:"issueTypeId,omitempty"`
+	IsGlobalContext bool   `json:"isGlobalContext,omitempty"`
+	IsAnyIssueType  bool   `json:"isAnyIssueType,omitempty"`
+}
@@ -0,0 +1,275 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+const filterExpand = "jql,owner,sharePermissions,editPermissions,subscriptions,favouritedCount,favourite,description,viewUrl,searchUrl"
+
+//// TABLE DEFINITION
+
+func tableFilter(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_filter",
+		Description: "Filters are saved searches that back boards, dashboards gadgets and subscriptions.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getFilter,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listFilters,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Optional},
+				{Name: "owner_account_id", Require: plugin.Optional},
+				{Name: "project_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the filter.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the filter.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the filter details.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of the filter.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "jql",
+				Description: "The JQL query of the filter.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JQL"),
+			},
+			{
+				Name:        "owner_account_id",
+				Description: "The account ID of the user who owns the filter.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Owner.AccountID"),
+			},
+			{
+				Name:        "owner_display_name",
+				Description: "The display name of the user who owns the filter.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Owner.DisplayName"),
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of a project the filter is shared with. Only populated when used as a qual.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "favourite",
+				Description: "Whether the filter is selected as a favorite by the current user.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "favourited_count",
+				Description: "The number of users who have this filter as a favorite.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "view_url",
+				Description: "A URL to view the filter results in Jira.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ViewURL"),
+			},
+			{
+				Name:        "search_url",
+				Description: "A URL to view the filter results in the REST API.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SearchURL"),
+			},
+
+			// JSON fields
+			{
+				Name:        "share_permissions",
+				Description: "The groups, projects, roles and users the filter is shared with.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "edit_permissions",
+				Description: "The groups, projects, roles and users that can edit the filter.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "subscriptions",
+				Description: "The users and groups subscribed to the filter.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Subscriptions.Items"),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listFilters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_filter.listFilters", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 100
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 100 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	query := ""
+	if d.EqualsQualString("name") != "" {
+		query = fmt.Sprintf("%s&filterName=%s", query, url.QueryEscape(d.EqualsQualString("name")))
+	}
+	if d.EqualsQualString("owner_account_id") != "" {
+		query = fmt.Sprintf("%s&accountId=%s", query, url.QueryEscape(d.EqualsQualString("owner_account_id")))
+	}
+	if d.EqualsQualString("project_id") != "" {
+		query = fmt.Sprintf("%s&projectId=%s", query, url.QueryEscape(d.EqualsQualString("project_id")))
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf(
+			"rest/api/3/filter/search?expand=%s&startAt=%d&maxResults=%d%s",
+			filterExpand,
+			last,
+			maxResults,
+			query,
+		)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_filter.listFilters", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListFilterResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_filter.listFilters", "api_error", err)
+			return nil, err
+		}
+
+		for _, filter := range listResult.Values {
+			d.StreamListItem(ctx, filter)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getFilter(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	filterId := d.EqualsQuals["id"].GetInt64Value()
+	if filterId == 0 {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_filter.getFilter", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/filter/%d?expand=%s", filterId, filterExpand)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_filter.getFilter", "get_request_error", err)
+		return nil, err
+	}
+
+	filter := new(Filter)
+	_, err = client.Do(req, filter)
+	if err != nil {
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_filter.getFilter", "api_error", err)
+		return nil, err
+	}
+
+	return *filter, nil
+}
+
+//// Custom Structs
+
+type ListFilterResult struct {
+	MaxResults int      `json:"maxResults"`
+	StartAt    int      `json:"startAt"`
+	Total      int      `json:"total"`
+	IsLast     bool     `json:"isLast"`
+	Values     []Filter `json:"values"`
+}
+
+type Filter struct {
+	ID               int64                   `json:"id,string"`
+	Self             string                  `json:"self"`
+	Name             string                  `json:"name"`
+	Description      string                  `json:"description"`
+	JQL              string                  `json:"jql"`
+	Owner            V3User                  `json:"owner"`
+	ViewURL          string                  `json:"viewUrl"`
+	SearchURL        string                  `json:"searchUrl"`
+	Favourite        bool                    `json:"favourite"`
+	FavouritedCount  int64                   `json:"favouritedCount"`
+	SharePermissions []SharePermissionDetail `json:"sharePermissions"`
+	EditPermissions  []SharePermissionDetail `json:"editPermissions"`
+	Subscriptions    FilterSubscriptions     `json:"subscriptions"`
+}
+
+type SharePermissionDetail struct {
+	ID      int64                  `json:"id"`
+	Type    string                 `json:"type"`
+	Project map[string]interface{} `json:"project,omitempty"`
+	Role    map[string]interface{} `json:"role,omitempty"`
+	Group   map[string]interface{} `json:"group,omitempty"`
+	User    map[string]interface{} `json:"user,omitempty"`
+	View    bool                   `json:"view,omitempty"`
+	Edit    bool                   `json:"edit,omitempty"`
+}
+
+type FilterSubscriptions struct {
+	Size  int           `json:"size"`
+	Items []interface{} `json:"items"`
+}
@@ -0,0 +1,183 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableInstalledApp(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_installed_app",
+		Description: "Apps and plugins installed in Jira, as reported by the Universal Plugin Manager.",
+		List: &plugin.ListConfig{
+			Hydrate: listInstalledApps,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "user_installed", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "key",
+				Description: "The key of the app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The installed version of the app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "enabled",
+				Description: "Whether the app is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "user_installed",
+				Description: "Whether the app was installed by a user, rather than bundled with Jira.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "optional",
+				Description: "Whether the app can be disabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "uses_licensing",
+				Description: "Whether the app requires a licence.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vendor_name",
+				Description: "The name of the vendor of the app.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Vendor.Name").NullIfZero(),
+			},
+			{
+				Name:        "vendor_url",
+				Description: "The website of the vendor of the app.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Vendor.Link").NullIfZero(),
+			},
+			{
+				Name:        "marketplace_url",
+				Description: "The Atlassian Marketplace page of the vendor of the app.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Vendor.MarketplaceLink").NullIfZero(),
+			},
+			{
+				Name:        "deployment_type",
+				Description: "The deployment type of the Jira instance. Possible values are Cloud, Server and DataCenter.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+			{
+				Name:        "scopes",
+				Description: "The access scopes granted to the app, such as READ, WRITE or ADMIN. Null if the plugin manager does not report them for the app.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listInstalledApps(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	deploymentType, err := getDeploymentType(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_installed_app.listInstalledApps", "connection_error", err)
+		return nil, err
+	}
+
+	// The plugin manager lists the apps of Data Center instances, and the
+	// apps installed from the Marketplace in Cloud sites
+	req, err := client.NewRequest("GET", "rest/plugins/1.0/", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_installed_app.listInstalledApps", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListInstalledAppResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		// The plugin manager can only be used by Jira administrators
+		if isNotFoundError(err) || isForbiddenError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_installed_app.listInstalledApps", "api_error", err)
+		return nil, err
+	}
+
+	for _, app := range listResult.Plugins {
+		// Cloud sites also list the internal modules of Jira itself, which
+		// cannot be managed, so only apps installed by users are returned
+		if deploymentType == "Cloud" && !app.UserInstalled {
+			continue
+		}
+		if d.EqualsQuals["user_installed"] != nil && d.EqualsQuals["user_installed"].GetBoolValue() != app.UserInstalled {
+			continue
+		}
+
+		app.DeploymentType = deploymentType
+		d.StreamListItem(ctx, app)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type ListInstalledAppResult struct {
+	Plugins []InstalledApp `json:"plugins"`
+}
+
+type InstalledApp struct {
+	Key           string `json:"key"`
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	Enabled       bool   `json:"enabled"`
+	UserInstalled bool   `json:"userInstalled"`
+	Optional      bool   `json:"optional"`
+	UsesLicensing bool   `json:"usesLicensing"`
+	Description   string `json:"description"`
+	Vendor        struct {
+		Name            string `json:"name"`
+		Link            string `json:"link"`
+		MarketplaceLink string `json:"marketplaceLink"`
+	} `json:"vendor"`
+	Scopes         []string `json:"scopes"`
+	DeploymentType string   `json:"-"`
+}
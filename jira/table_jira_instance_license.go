@@ -0,0 +1,94 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableInstanceLicense(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_instance_license",
+		Description: "The licensed applications of a Jira Cloud instance and their plans.",
+		List: &plugin.ListConfig{
+			Hydrate: listInstanceLicenses,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "application_id",
+				Description: "The ID of the licensed application, for example jira-software.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "plan",
+				Description: "The licence plan of the application. Possible values are UNLICENSED, FREE and PAID.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listInstanceLicenses(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// The licence of Server and Data Center instances is not available
+	// through the REST API
+	cloud, err := isJiraCloud(ctx, d)
+	if err != nil || !cloud {
+		return nil, err
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_instance_license.listInstanceLicenses", "connection_error", err)
+		return nil, err
+	}
+
+	req, err := client.NewRequest("GET", "rest/api/3/instance/license", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_instance_license.listInstanceLicenses", "get_request_error", err)
+		return nil, err
+	}
+
+	license := new(InstanceLicense)
+	_, err = client.Do(req, license)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_instance_license.listInstanceLicenses", "api_error", err)
+		return nil, err
+	}
+
+	for _, application := range license.Applications {
+		d.StreamListItem(ctx, application)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type InstanceLicense struct {
+	Applications []LicensedApplication `json:"applications"`
+}
+
+type LicensedApplication struct {
+	ID   string `json:"id"`
+	Plan string `json:"plan"`
+}
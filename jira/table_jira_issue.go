@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/andygrunwald/go-jira"
@@ -24,17 +25,19 @@ func tableIssue(_ context.Context) *plugin.Table {
 			Hydrate:    getIssue,
 		},
 		List: &plugin.ListConfig{
-			ParentHydrate: listProjects,
+			ParentHydrate: listIssueProjects,
 			Hydrate:       listIssues,
 			// https://support.atlassian.com/jira-service-management-cloud/docs/advanced-search-reference-jql-fields/
 			KeyColumns: plugin.KeyColumnSlice{
 				{Name: "assignee_account_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "assignee_display_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "classification_level", Require: plugin.Optional, Operators: []string{"=", "<>", "is null", "is not null"}},
 				{Name: "created", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}},
 				{Name: "creator_account_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "creator_display_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "duedate", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}},
 				{Name: "epic_key", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "filter_id", Require: plugin.Optional, Operators: []string{"="}},
 				{Name: "priority", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "project_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "project_key", Require: plugin.Optional, Operators: []string{"=", "<>"}},
@@ -44,6 +47,7 @@ func tableIssue(_ context.Context) *plugin.Table {
 				{Name: "resolution_date", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}},
 				{Name: "status", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "status_category", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "status_id", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "type", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 				{Name: "updated", Require: plugin.Optional, Operators: []string{"=", ">", ">=", "<=", "<"}},
 			},
@@ -85,6 +89,12 @@ func tableIssue(_ context.Context) *plugin.Table {
 				Hydrate:     getStatusValue,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "status_id",
+				Description: "The ID of the status of the issue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("V3Issue.Fields.Status.ID"),
+			},
 			{
 				Name:        "status_category",
 				Description: "The status category (Open, In Progress, Done) of the ticket.",
@@ -97,6 +107,12 @@ func tableIssue(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromP(extractRequiredField, "epic"),
 			},
+			{
+				Name:        "filter_id",
+				Description: "The ID of a saved filter whose JQL is used to select the issues. Only populated when used as a qual.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("filter_id"),
+			},
 			{
 				Name:        "sprint_ids",
 				Description: "The list of ids of the sprint to which issue belongs.",
@@ -171,6 +187,18 @@ func tableIssue(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("V3Issue.Fields.Priority.Name"),
 			},
+			{
+				Name:        "security_level",
+				Description: "The name of the security level that restricts who can see the issue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("V3Issue.Fields.Security.Name"),
+			},
+			{
+				Name:        "classification_level",
+				Description: "The name of the data classification level of the issue. Only available in Jira Cloud.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("V3Issue.Fields.Classification").Transform(extractClassificationLevelName),
+			},
 			{
 				Name:        "project_name",
 				Description: "Name of the project to that issue belongs.",
@@ -267,26 +295,73 @@ func listIssues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		}
 	}
 
+	// Issues of a saved filter are searched once across all projects, and the
+	// project quals are applied through the JQL instead
+	filterSearch := d.EqualsQuals["filter_id"] != nil
+
 	projectId := d.EqualsQualString("project_id")
 	projectName := d.EqualsQualString("project_name")
 	projectKey := d.EqualsQualString("project_key")
 
-	if projectId != "" && projectId != project.ID {
-		return nil, nil
-	}
-	if projectName != "" && projectName != project.Name {
-		return nil, nil
-	}
-	if projectKey != "" && projectKey != project.Key {
-		return nil, nil
+	if !filterSearch {
+		if projectId != "" && projectId != project.ID {
+			return nil, nil
+		}
+		if projectName != "" && projectName != project.Name {
+			return nil, nil
+		}
+		if projectKey != "" && projectKey != project.Key {
+			return nil, nil
+		}
 	}
 
 	jql := ""
 
-	qualJQL := buildJQLQueryFromQuals(d.Quals, d.Table.Columns)
+	// Data classification only exists in Jira Cloud, where the quals are
+	// pushed down to the JQL. Other deployments reject the field, so the
+	// quals are left for Steampipe to apply to the returned rows.
+	columns := d.Table.Columns
+	if d.Quals["classification_level"] != nil {
+		cloud, err := isJiraCloud(ctx, d)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue.listIssues", "server_info_error", err)
+			return nil, err
+		}
+		if !cloud {
+			columns = []*plugin.Column{}
+			for _, column := range d.Table.Columns {
+				if column.Name != "classification_level" {
+					columns = append(columns, column)
+				}
+			}
+		}
+	}
+
+	qualJQL := buildJQLQueryFromQuals(d.Quals, columns)
+
+	// AND the JQL of the saved filter with the other quals
+	if d.EqualsQuals["filter_id"] != nil {
+		filterJQL, err := getFilterJQL(ctx, d, d.EqualsQuals["filter_id"].GetInt64Value())
+		if err != nil {
+			if isNotFoundError(err) || isBadRequestError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_issue.listIssues", "filter_error", err)
+			return nil, err
+		}
+		if filterJQL != "" {
+			if qualJQL == "" {
+				qualJQL = fmt.Sprintf("(%s)", filterJQL)
+			} else {
+				qualJQL = fmt.Sprintf("%s AND (%s)", qualJQL, filterJQL)
+			}
+		}
+	}
 
 	// Always include project key to avoid unbounded JQL error
-	if qualJQL == "" {
+	if filterSearch {
+		jql = qualJQL
+	} else if qualJQL == "" {
 		jql = fmt.Sprintf("project=%s", project.Key)
 	} else {
 		jql = fmt.Sprintf("project=%s AND %s", project.Key, qualJQL)
@@ -351,6 +426,17 @@ func listIssues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 
 //// HELPER FUNCTIONS
 
+// listIssueProjects is the parent of listIssues. The JQL of a saved filter
+// selects issues across projects, so when filter_id is set a single empty
+// project is returned and the filter is searched once.
+func listIssueProjects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	if d.EqualsQuals["filter_id"] != nil {
+		d.StreamListItem(ctx, Project{})
+		return nil, nil
+	}
+	return listProjects(ctx, d, h)
+}
+
 // getRequiredFields determines which fields are needed based on the selected columns
 func getRequiredFields(ctx context.Context, d *plugin.QueryData) []string {
 	// Map of column names to their corresponding field names
@@ -365,6 +451,7 @@ func getRequiredFields(ctx context.Context, d *plugin.QueryData) []string {
 		"project_id":      "project",
 		"project_name":    "project",
 		"status":          "status",
+		"status_id":       "status",
 		"status_category": "statusCategory",
 
 		// User fields
@@ -380,10 +467,12 @@ func getRequiredFields(ctx context.Context, d *plugin.QueryData) []string {
 		"resolution_date": "resolutiondate",
 
 		// Other fields
-		"priority":   "priority",
-		"labels":     "labels",
-		"components": "components",
-		"work_log":   "worklog",
+		"priority":             "priority",
+		"security_level":       "security",
+		"classification_level": "classification",
+		"labels":               "labels",
+		"components":           "components",
+		"work_log":             "worklog",
 
 		// JSON fields that need the full field object
 		"fields": "*all", // Need all fields for this
@@ -702,6 +791,22 @@ func extractSprintNames(ctx context.Context, d *transform.TransformData) (interf
 	return nil, nil
 }
 
+// extractClassificationLevelName returns the name of the classification
+// level of an issue, which is returned either as an object or as a name
+func extractClassificationLevelName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch v := d.Value.(type) {
+	case map[string]interface{}:
+		if name, ok := v["name"].(string); ok && name != "" {
+			return name, nil
+		}
+	case string:
+		if v != "" {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
 func getIssueTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	issue := d.HydrateItem.(IssueInfo)
 
@@ -846,6 +951,69 @@ func getCustomFieldMappings(ctx context.Context, d *plugin.QueryData) (map[strin
 	return mappings, nil
 }
 
+// getFilterJQL retrieves and caches the JQL of a saved filter, without any ORDER BY clause
+func getFilterJQL(ctx context.Context, d *plugin.QueryData, filterId int64) (string, error) {
+	cacheKey := fmt.Sprintf("filter_jql_%d", filterId)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(string), nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue.getFilterJQL", "connection_error", err)
+		return "", err
+	}
+
+	req, err := client.NewRequestWithContext(ctx, "GET", fmt.Sprintf("rest/api/3/filter/%d?expand=jql", filterId), nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue.getFilterJQL", "request_creation_error", err)
+		return "", err
+	}
+
+	filter := new(Filter)
+	_, err = client.Do(req, filter)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue.getFilterJQL", "api_error", err)
+		return "", err
+	}
+
+	// The ORDER BY clause cannot be combined with other conditions
+	jql := stripJQLOrderBy(filter.JQL)
+
+	d.ConnectionManager.Cache.Set(cacheKey, jql)
+	return jql, nil
+}
+
+// jqlOrderByRegexp matches the ORDER BY keywords of a JQL query
+var jqlOrderByRegexp = regexp.MustCompile(`(?i)\border\s+by\b`)
+
+// stripJQLOrderBy removes the ORDER BY clause from a JQL query. Quoted text
+// is ignored, so that values such as summary ~ "order by" are kept.
+func stripJQLOrderBy(jql string) string {
+	// Blank out quoted text, keeping the positions of the other characters
+	masked := []byte(jql)
+	var quote byte
+	for i := 0; i < len(masked); i++ {
+		c := masked[i]
+		switch {
+		case quote != 0 && c == '\\' && i+1 < len(masked):
+			masked[i], masked[i+1] = ' ', ' '
+			i++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+			masked[i] = ' '
+		case c == '"' || c == '\'':
+			quote = c
+		}
+	}
+
+	if index := jqlOrderByRegexp.FindIndex(masked); index != nil {
+		jql = jql[:index[0]]
+	}
+	return strings.TrimSpace(jql)
+}
+
 func searchWithContext(ctx context.Context, d *plugin.QueryData, requestBody map[string]interface{}) (*searchResult, *jira.Response, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -948,7 +1116,9 @@ type V3Fields struct {
 	AggregateTimeEstimate *int             `json:"aggregatetimeestimate"`
 	Watches               V3Watches        `json:"watches"`
 	LastViewed            *string          `json:"lastViewed"`
-	Parent                *V3Parent        `json:"parent"` // Can be null for top-level issues
+	Parent                *V3Parent        `json:"parent"`         // Can be null for top-level issues
+	Security              *V3SecurityLevel `json:"security"`       // Can be null
+	Classification        interface{}      `json:"classification"` // Only set in Jira Cloud
 	// Store the raw JSON for dynamic field access
 	RawFields json.RawMessage `json:"-"`
 }
@@ -1043,6 +1213,13 @@ type V3Resolution struct {
 	Name        string `json:"name"`
 }
 
+type V3SecurityLevel struct {
+	Self        string `json:"self"`
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Name        string `json:"name"`
+}
+
 type V3Component struct {
 	Self        string `json:"self"`
 	ID          string `json:"id"`
@@ -0,0 +1,261 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueDevSummary(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_dev_summary",
+		Description: "Summary of the development information (branches, commits, pull requests, builds and deployments) linked to an issue.",
+		List: &plugin.ListConfig{
+			Hydrate: listIssueDevSummaries,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "issue_id", Require: plugin.Required},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// top fields
+			{
+				Name:        "issue_id",
+				Description: "The ID of the issue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "branch_count",
+				Description: "The number of branches linked to the issue.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.Branch.Overall.Count"),
+			},
+			{
+				Name:        "branch_last_updated",
+				Description: "Time when a linked branch was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Summary.Branch.Overall.LastUpdated").Transform(convertJiraTime),
+			},
+			{
+				Name:        "commit_count",
+				Description: "The number of commits linked to the issue.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.Repository.Overall.Count"),
+			},
+			{
+				Name:        "commit_last_updated",
+				Description: "Time when a linked commit was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Summary.Repository.Overall.LastUpdated").Transform(convertJiraTime),
+			},
+			{
+				Name:        "pull_request_count",
+				Description: "The number of pull requests linked to the issue.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.PullRequest.Overall.Count"),
+			},
+			{
+				Name:        "pull_request_state",
+				Description: "The overall state of the linked pull requests. Possible values are OPEN, MERGED and DECLINED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Summary.PullRequest.Overall.State").NullIfZero(),
+			},
+			{
+				Name:        "pull_request_open_count",
+				Description: "The number of linked pull requests that are open.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.PullRequest.Overall.Details.OpenCount"),
+			},
+			{
+				Name:        "pull_request_merged_count",
+				Description: "The number of linked pull requests that are merged.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.PullRequest.Overall.Details.MergedCount"),
+			},
+			{
+				Name:        "pull_request_declined_count",
+				Description: "The number of linked pull requests that are declined.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.PullRequest.Overall.Details.DeclinedCount"),
+			},
+			{
+				Name:        "pull_request_last_updated",
+				Description: "Time when a linked pull request was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Summary.PullRequest.Overall.LastUpdated").Transform(convertJiraTime),
+			},
+			{
+				Name:        "build_count",
+				Description: "The number of builds linked to the issue.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.Build.Overall.Count"),
+			},
+			{
+				Name:        "build_successful_count",
+				Description: "The number of linked builds that succeeded.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.Build.Overall.SuccessfulBuildCount"),
+			},
+			{
+				Name:        "build_failed_count",
+				Description: "The number of linked builds that failed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.Build.Overall.FailedBuildCount"),
+			},
+			{
+				Name:        "build_unknown_count",
+				Description: "The number of linked builds with an unknown state.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.Build.Overall.UnknownBuildCount"),
+			},
+			{
+				Name:        "build_last_updated",
+				Description: "Time when a linked build was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Summary.Build.Overall.LastUpdated").Transform(convertJiraTime),
+			},
+			{
+				Name:        "deployment_count",
+				Description: "The number of deployment environments linked to the issue.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.DeploymentEnvironment.Overall.Count"),
+			},
+			{
+				Name:        "deployment_successful_count",
+				Description: "The number of linked deployments that succeeded.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Summary.DeploymentEnvironment.Overall.SuccessfulCount"),
+			},
+			{
+				Name:        "deployment_last_updated",
+				Description: "Time when a linked deployment was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Summary.DeploymentEnvironment.Overall.LastUpdated").Transform(convertJiraTime),
+			},
+
+			// JSON fields
+			{
+				Name:        "deployment_top_environments",
+				Description: "The environments with the most recent deployments linked to the issue.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Summary.DeploymentEnvironment.Overall.TopEnvironments"),
+			},
+			{
+				Name:        "summary",
+				Description: "The raw development information summary of the issue.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueDevSummaries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	issueId := d.EqualsQualString("issue_id")
+
+	// Minimize the API call for given issue ID.
+	if issueId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_dev_summary.listIssueDevSummaries", "connection_error", err)
+		return nil, err
+	}
+
+	// The dev-status API only accepts the numeric issue ID, not the issue key
+	apiEndpoint := fmt.Sprintf("rest/dev-status/latest/issue/summary?issueId=%s", issueId)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_dev_summary.listIssueDevSummaries", "get_request_error", err)
+		return nil, err
+	}
+
+	result := new(DevStatusSummaryResult)
+	_, err = client.Do(req, result)
+	if err != nil {
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_dev_summary.listIssueDevSummaries", "api_error", err)
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, devSummaryWithIssueDetails{*result, issueId})
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type DevStatusSummaryResult struct {
+	Errors       []interface{}    `json:"errors"`
+	ConfigErrors []interface{}    `json:"configErrors"`
+	Summary      DevStatusSummary `json:"summary"`
+}
+
+type DevStatusSummary struct {
+	PullRequest struct {
+		Overall DevStatusPullRequestOverall `json:"overall"`
+	} `json:"pullrequest"`
+	Build struct {
+		Overall DevStatusBuildOverall `json:"overall"`
+	} `json:"build"`
+	Review struct {
+		Overall DevStatusOverall `json:"overall"`
+	} `json:"review"`
+	DeploymentEnvironment struct {
+		Overall DevStatusDeploymentOverall `json:"overall"`
+	} `json:"deployment-environment"`
+	Repository struct {
+		Overall DevStatusOverall `json:"overall"`
+	} `json:"repository"`
+	Branch struct {
+		Overall DevStatusOverall `json:"overall"`
+	} `json:"branch"`
+}
+
+type DevStatusOverall struct {
+	Count       int     `json:"count"`
+	LastUpdated *string `json:"lastUpdated"`
+}
+
+type DevStatusPullRequestOverall struct {
+	DevStatusOverall
+	State      string `json:"state"`
+	StateCount int    `json:"stateCount"`
+	Open       bool   `json:"open"`
+	Details    struct {
+		OpenCount     int `json:"openCount"`
+		MergedCount   int `json:"mergedCount"`
+		DeclinedCount int `json:"declinedCount"`
+		Total         int `json:"total"`
+	} `json:"details"`
+}
+
+type DevStatusBuildOverall struct {
+	DevStatusOverall
+	FailedBuildCount     int `json:"failedBuildCount"`
+	SuccessfulBuildCount int `json:"successfulBuildCount"`
+	UnknownBuildCount    int `json:"unknownBuildCount"`
+}
+
+type DevStatusDeploymentOverall struct {
+	DevStatusOverall
+	TopEnvironments []interface{} `json:"topEnvironments"`
+	ShowProjects    bool          `json:"showProjects"`
+	SuccessfulCount int           `json:"successfulCount"`
+}
+
+type devSummaryWithIssueDetails struct {
+	DevStatusSummaryResult
+	IssueId string
+}
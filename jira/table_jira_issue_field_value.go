@@ -0,0 +1,291 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueFieldValue(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_field_value",
+		Description: "Custom field values of issues, with one row per issue and custom field.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listIssueFieldValueProjects,
+			Hydrate:       listIssueFieldValues,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "project_key", Require: plugin.Optional},
+				{Name: "issue_key", Require: plugin.Optional},
+				{Name: "field_id", Require: plugin.Optional},
+				{Name: "field_name", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "issue_id",
+				Description: "The ID of the issue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "issue_key",
+				Description: "The key of the issue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_key",
+				Description: "The key of the project the issue belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "field_id",
+				Description: "The ID of the custom field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "field_name",
+				Description: "The name of the custom field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "field_type",
+				Description: "The data type of the custom field, for example string, number, option, user or array.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value_string",
+				Description: "The value of the field as text. Options and versions are represented by their value or name, users by their display name and arrays as a comma-separated list.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value_number",
+				Description: "The value of the field, if it is a number.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "value_timestamp",
+				Description: "The value of the field, if it is a date or date time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "value_json",
+				Description: "The raw value of the field.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueFieldValues(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(Project)
+
+	// An issue is searched once by its key rather than in every project, as
+	// issues that were moved keep their old key
+	issueKey := d.EqualsQualString("issue_key")
+
+	projectKey := d.EqualsQualString("project_key")
+	if issueKey == "" && projectKey != "" && projectKey != project.Key {
+		return nil, nil
+	}
+
+	fieldDetails, err := getFieldDetails(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_field_value.listIssueFieldValues", "field_details_error", err)
+		return nil, err
+	}
+
+	// Request only the custom fields that match the field quals
+	fieldId := d.EqualsQualString("field_id")
+	fieldName := d.EqualsQualString("field_name")
+	requiredFields := []string{}
+	for _, field := range fieldDetails {
+		if !field.Custom {
+			continue
+		}
+		if fieldId != "" && field.ID != fieldId {
+			continue
+		}
+		if fieldName != "" && field.Name != fieldName {
+			continue
+		}
+		requiredFields = append(requiredFields, field.ID)
+	}
+	if len(requiredFields) == 0 {
+		return nil, nil
+	}
+
+	jql := fmt.Sprintf("project=%s", quoteJQLValue(project.Key))
+	if issueKey != "" {
+		jql = fmt.Sprintf("key = %s", quoteJQLValue(issueKey))
+		if projectKey != "" {
+			jql = fmt.Sprintf("%s AND project=%s", jql, quoteJQLValue(projectKey))
+		}
+	}
+
+	requestBody := map[string]interface{}{
+		"jql":        jql,
+		"maxResults": 100,
+		"fields":     append([]string{"project"}, requiredFields...),
+	}
+
+	for {
+		searchResult, _, err := searchWithContext(ctx, d, requestBody)
+		if err != nil {
+			if isNotFoundError(err) || isBadRequestError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_issue_field_value.listIssueFieldValues", "search_error", err)
+			return nil, err
+		}
+
+		for _, issue := range searchResult.Issues {
+			var fieldsMap map[string]interface{}
+			if err := json.Unmarshal(issue.Fields.RawFields, &fieldsMap); err != nil {
+				plugin.Logger(ctx).Error("jira_issue_field_value.listIssueFieldValues", "unmarshal_error", err)
+				return nil, err
+			}
+
+			for _, id := range requiredFields {
+				value, ok := fieldsMap[id]
+				if !ok || value == nil {
+					continue
+				}
+
+				field := fieldDetails[id]
+				item := normaliseFieldValue(field.Schema.Type, value)
+				item.IssueId = issue.ID
+				item.IssueKey = issue.Key
+				item.ProjectKey = project.Key
+				if issueProject, ok := fieldsMap["project"].(map[string]interface{}); ok {
+					item.ProjectKey, _ = issueProject["key"].(string)
+				}
+				item.FieldId = field.ID
+				item.FieldName = field.Name
+				item.FieldType = field.Schema.Type
+
+				d.StreamListItem(ctx, item)
+				// Context may get cancelled due to manual cancellation or if the limit has been reached
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		if searchResult.IsLast || searchResult.NextPageToken == "" {
+			return nil, nil
+		}
+		requestBody["nextPageToken"] = searchResult.NextPageToken
+	}
+}
+
+//// UTILITY FUNCTIONS
+
+// listIssueFieldValueProjects lists the projects to search for field values.
+// A single placeholder project is streamed when the issue_key qual is set, as
+// the issue is then searched once across all projects.
+func listIssueFieldValueProjects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	if d.EqualsQualString("issue_key") != "" {
+		d.StreamListItem(ctx, Project{})
+		return nil, nil
+	}
+	return listProjects(ctx, d, h)
+}
+
+// normaliseFieldValue flattens the different shapes a field value can take
+// (scalars, options, users, versions, rich text and arrays of those) into
+// string, number and timestamp representations.
+func normaliseFieldValue(fieldType string, value interface{}) IssueFieldValue {
+	item := IssueFieldValue{ValueJson: value}
+
+	text := fieldValueText(value)
+	if text != "" {
+		item.ValueString = &text
+	}
+
+	switch v := value.(type) {
+	case float64:
+		item.ValueNumber = &v
+	case string:
+		switch fieldType {
+		case "date":
+			if t, err := time.Parse(time.DateOnly, v); err == nil {
+				item.ValueTimestamp = &t
+			}
+		case "datetime":
+			if t, err := time.Parse("2006-01-02T15:04:05.000-0700", v); err == nil {
+				item.ValueTimestamp = &t
+			}
+		case "number":
+			if n, err := strconv.ParseFloat(v, 64); err == nil {
+				item.ValueNumber = &n
+			}
+		}
+	}
+
+	return item
+}
+
+// fieldValueText returns the human readable text of a field value
+func fieldValueText(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		texts := []string{}
+		for _, item := range v {
+			if text := fieldValueText(item); text != "" {
+				texts = append(texts, text)
+			}
+		}
+		return strings.Join(texts, ", ")
+	case map[string]interface{}:
+		// Atlassian Document Format, used by rich text fields
+		if v["type"] == "doc" {
+			texts := []string{}
+			extractText(v, &texts)
+			return strings.Join(texts, "\n")
+		}
+
+		// Cascading select options hold the selected child option
+		if child, ok := v["child"].(map[string]interface{}); ok {
+			return fmt.Sprintf("%s - %s", fieldValueText(v["value"]), fieldValueText(child))
+		}
+
+		// Options, versions, components, users and linked issues
+		for _, key := range []string{"value", "name", "displayName", "key", "id"} {
+			if text, ok := v[key].(string); ok && text != "" {
+				return text
+			}
+		}
+	}
+	return ""
+}
+
+//// Custom Structs
+
+type IssueFieldValue struct {
+	IssueId        string
+	IssueKey       string
+	ProjectKey     string
+	FieldId        string
+	FieldName      string
+	FieldType      string
+	ValueString    *string
+	ValueNumber    *float64
+	ValueTimestamp *time.Time
+	ValueJson      interface{}
+}
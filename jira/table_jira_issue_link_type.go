@@ -0,0 +1,141 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueLinkType(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_link_type",
+		Description: "Issue link types define the relationships that can be created between issues, such as blocks or duplicates.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getIssueLinkType,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIssueLinkTypes,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the issue link type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the issue link type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "inward",
+				Description: "The description of the issue link type inward link, for example is blocked by.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "outward",
+				Description: "The description of the issue link type outward link, for example blocks.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the issue link type.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueLinkTypes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_link_type.listIssueLinkTypes", "connection_error", err)
+		return nil, err
+	}
+
+	req, err := client.NewRequest("GET", "rest/api/3/issueLinkType", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_link_type.listIssueLinkTypes", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListIssueLinkTypeResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_link_type.listIssueLinkTypes", "api_error", err)
+		return nil, err
+	}
+
+	for _, linkType := range listResult.IssueLinkTypes {
+		d.StreamListItem(ctx, linkType)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIssueLinkType(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	linkTypeId := d.EqualsQualString("id")
+	if linkTypeId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_link_type.getIssueLinkType", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/issueLinkType/%s", url.PathEscape(linkTypeId))
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_link_type.getIssueLinkType", "get_request_error", err)
+		return nil, err
+	}
+
+	linkType := new(V3IssueLinkType)
+	_, err = client.Do(req, linkType)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_link_type.getIssueLinkType", "api_error", err)
+		return nil, err
+	}
+
+	return *linkType, nil
+}
+
+//// Custom Structs
+
+type ListIssueLinkTypeResult struct {
+	IssueLinkTypes []V3IssueLinkType `json:"issueLinkTypes"`
+}
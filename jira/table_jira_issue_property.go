@@ -0,0 +1,195 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueProperty(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_property",
+		Description: "Issue properties are entity properties that store custom data against an issue, typically set by apps.",
+		List: &plugin.ListConfig{
+			Hydrate: listIssueProperties,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "issue_key", Require: plugin.AnyOf},
+				{Name: "jql", Require: plugin.AnyOf},
+				{Name: "key", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// top fields
+			{
+				Name:        "issue_key",
+				Description: "The key of the issue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "key",
+				Description: "The key of the issue property.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "jql",
+				Description: "The JQL query used to select the issues, for example issue.property[deployment].state = \"failed\".",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("jql"),
+			},
+
+			// JSON fields
+			{
+				Name:        "value",
+				Description: "The value of the issue property.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Key"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueProperties(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	issueKey := d.EqualsQualString("issue_key")
+	jql := d.EqualsQualString("jql")
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_property.listIssueProperties", "connection_error", err)
+		return nil, err
+	}
+
+	// Fetch the properties directly if no JQL filter is provided
+	if jql == "" {
+		if issueKey == "" {
+			return nil, nil
+		}
+		return nil, streamIssueProperties(ctx, d, client, issueKey)
+	}
+
+	if issueKey != "" {
+		jql = fmt.Sprintf("key = %s AND (%s)", quoteJQLValue(issueKey), jql)
+	}
+
+	requestBody := map[string]interface{}{
+		"jql":        jql,
+		"maxResults": 500,
+		"fields":     []string{"key"},
+	}
+
+	for {
+		searchResult, _, err := searchWithContext(ctx, d, requestBody)
+		// An invalid jql qual is reported, rather than returning no rows
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_property.listIssueProperties", "search_error", err)
+			return nil, err
+		}
+
+		for _, issue := range searchResult.Issues {
+			if err := streamIssueProperties(ctx, d, client, issue.Key); err != nil {
+				return nil, err
+			}
+
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if searchResult.IsLast || searchResult.NextPageToken == "" {
+			return nil, nil
+		}
+		requestBody["nextPageToken"] = searchResult.NextPageToken
+	}
+}
+
+// streamIssueProperties streams the properties of a single issue, restricted
+// to the property key qual if one is provided.
+func streamIssueProperties(ctx context.Context, d *plugin.QueryData, client *jira.Client, issueKey string) error {
+	propertyKeys := []string{}
+	if key := d.EqualsQualString("key"); key != "" {
+		propertyKeys = append(propertyKeys, key)
+	} else {
+		keys, err := getIssuePropertyKeys(ctx, client, issueKey)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			propertyKeys = append(propertyKeys, key.Key)
+		}
+	}
+
+	for _, key := range propertyKeys {
+		apiEndpoint := fmt.Sprintf("rest/api/3/issue/%s/properties/%s", issueKey, url.PathEscape(key))
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_property.streamIssueProperties", "get_request_error", err)
+			return err
+		}
+
+		property := new(KeyPropertyValue)
+		_, err = client.Do(req, property)
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			plugin.Logger(ctx).Error("jira_issue_property.streamIssueProperties", "api_error", err)
+			return err
+		}
+
+		d.StreamListItem(ctx, issuePropertyWithIssueDetails{*property, issueKey})
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func getIssuePropertyKeys(ctx context.Context, client *jira.Client, issueKey string) ([]ProjectKey, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/issue/%s/properties", issueKey)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_property.getIssuePropertyKeys", "get_request_error", err)
+		return nil, err
+	}
+
+	keys := new(ProjectKeys)
+	_, err = client.Do(req, keys)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_property.getIssuePropertyKeys", "api_error", err)
+		return nil, err
+	}
+
+	return keys.Keys, nil
+}
+
+//// Custom Structs
+
+type issuePropertyWithIssueDetails struct {
+	KeyPropertyValue
+	IssueKey string
+}
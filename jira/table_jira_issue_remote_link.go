@@ -0,0 +1,228 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueRemoteLink(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_remote_link",
+		Description: "Remote issue links connect an issue to objects outside Jira, such as pull requests, builds and Confluence pages.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"issue_id", "id"}),
+			Hydrate:    getIssueRemoteLink,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIssueRemoteLinks,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "issue_id", Require: plugin.Required},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			// top fields
+			{
+				Name:        "id",
+				Description: "The ID of the remote link.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "issue_id",
+				Description: "The ID of the issue.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the remote link.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "global_id",
+				Description: "The global ID of the link, such as the ID of the item on the remote system.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GlobalID"),
+			},
+			{
+				Name:        "relationship",
+				Description: "The description of the relationship between the issue and the linked item.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "application_type",
+				Description: "The name-spaced type of the application, used by registered rendering apps.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Application.Type"),
+			},
+			{
+				Name:        "application_name",
+				Description: "The name of the application that the linked item belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Application.Name"),
+			},
+			{
+				Name:        "object_url",
+				Description: "The URL of the linked item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Object.URL"),
+			},
+			{
+				Name:        "object_title",
+				Description: "The title of the linked item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Object.Title"),
+			},
+			{
+				Name:        "object_summary",
+				Description: "The summary details of the linked item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Object.Summary"),
+			},
+			{
+				Name:        "object_resolved",
+				Description: "Whether the linked item is resolved, for example a merged pull request.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Object.Status.Resolved"),
+			},
+
+			// JSON fields
+			{
+				Name:        "object",
+				Description: "The details of the linked item, including its icon and status.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Object.Title"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueRemoteLinks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	issueId := d.EqualsQualString("issue_id")
+
+	// Minimize the API call for given issue ID.
+	if issueId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_remote_link.listIssueRemoteLinks", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/issue/%s/remotelink", issueId)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_remote_link.listIssueRemoteLinks", "get_request_error", err)
+		return nil, err
+	}
+
+	links := []RemoteLink{}
+	_, err = client.Do(req, &links)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_remote_link.listIssueRemoteLinks", "api_error", err)
+		return nil, err
+	}
+
+	for _, link := range links {
+		d.StreamListItem(ctx, remoteLinkWithIssueDetails{link, issueId})
+
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTION
+
+func getIssueRemoteLink(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	issueId := d.EqualsQualString("issue_id")
+	id := d.EqualsQuals["id"].GetInt64Value()
+
+	if issueId == "" || id == 0 {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_remote_link.getIssueRemoteLink", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/issue/%s/remotelink/%d", issueId, id)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_remote_link.getIssueRemoteLink", "get_request_error", err)
+		return nil, err
+	}
+
+	link := new(RemoteLink)
+	_, err = client.Do(req, link)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_remote_link.getIssueRemoteLink", "api_error", err)
+		return nil, err
+	}
+
+	return remoteLinkWithIssueDetails{*link, issueId}, nil
+}
+
+//// Custom Structs
+
+type RemoteLink struct {
+	ID           int64                 `json:"id"`
+	Self         string                `json:"self"`
+	GlobalID     string                `json:"globalId"`
+	Relationship string                `json:"relationship"`
+	Application  RemoteLinkApplication `json:"application"`
+	Object       RemoteLinkObject      `json:"object"`
+}
+
+type RemoteLinkApplication struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type RemoteLinkObject struct {
+	URL     string                 `json:"url"`
+	Title   string                 `json:"title"`
+	Summary string                 `json:"summary,omitempty"`
+	Icon    map[string]interface{} `json:"icon,omitempty"`
+	Status  RemoteLinkStatus       `json:"status"`
+}
+
+type RemoteLinkStatus struct {
+	Resolved bool                   `json:"resolved"`
+	Icon     map[string]interface{} `json:"icon,omitempty"`
+}
+
+type remoteLinkWithIssueDetails struct {
+	RemoteLink
+	IssueId string
+}
@@ -0,0 +1,158 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueSecurityLevelMember(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_security_level_member",
+		Description: "The users, groups, project roles and other holders that can see issues with each security level.",
+		List: &plugin.ListConfig{
+			Hydrate: listIssueSecurityLevelMembers,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "scheme_id", Require: plugin.Optional},
+				{Name: "level_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the security level member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "scheme_id",
+				Description: "The ID of the issue security scheme.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IssueSecuritySchemeID").Transform(convertStringToInt),
+			},
+			{
+				Name:        "level_id",
+				Description: "The ID of the security level.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IssueSecurityLevelID").Transform(convertStringToInt),
+			},
+			{
+				Name:        "holder_type",
+				Description: "The type of the member, for example user, group, projectRole, reporter or assignee.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Holder.Type"),
+			},
+			{
+				Name:        "holder_parameter",
+				Description: "The identifier associated with the holder type, such as the group name, project role ID or account ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Holder.Parameter").NullIfZero(),
+			},
+			{
+				Name:        "holder_display",
+				Description: "A human readable description of the member, such as the user display name, group name or project role name.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Holder").Transform(permissionHolderDisplayName),
+			},
+
+			// JSON fields
+			{
+				Name:        "holder",
+				Description: "The details of the member.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueSecurityLevelMembers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_security_level_member.listIssueSecurityLevelMembers", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 50
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 50 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	query := ""
+	if d.EqualsQuals["scheme_id"] != nil {
+		query = fmt.Sprintf("%s&schemeId=%d", query, d.EqualsQuals["scheme_id"].GetInt64Value())
+	}
+	if d.EqualsQuals["level_id"] != nil {
+		query = fmt.Sprintf("%s&levelId=%d", query, d.EqualsQuals["level_id"].GetInt64Value())
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf(
+			"rest/api/3/issuesecurityschemes/level/member?expand=all&startAt=%d&maxResults=%d%s",
+			last,
+			maxResults,
+			query,
+		)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_security_level_member.listIssueSecurityLevelMembers", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListIssueSecurityLevelMemberResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_issue_security_level_member.listIssueSecurityLevelMembers", "api_error", err)
+			return nil, err
+		}
+
+		for _, member := range listResult.Values {
+			d.StreamListItem(ctx, member)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type ListIssueSecurityLevelMemberResult struct {
+	Self       string                     `json:"self"`
+	NextPage   string                     `json:"nextPage"`
+	MaxResults int                        `json:"maxResults"`
+	StartAt    int                        `json:"startAt"`
+	Total      int                        `json:"total"`
+	IsLast     bool                       `json:"isLast"`
+	Values     []IssueSecurityLevelMember `json:"values"`
+}
+
+type IssueSecurityLevelMember struct {
+	ID                    string           `json:"id"`
+	IssueSecuritySchemeID string           `json:"issueSecuritySchemeId"`
+	IssueSecurityLevelID  string           `json:"issueSecurityLevelId"`
+	Holder                PermissionHolder `json:"holder"`
+}
@@ -0,0 +1,154 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueSecurityScheme(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_security_scheme",
+		Description: "Issue security schemes define the security levels that can be set on issues to restrict who can see them.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getIssueSecurityScheme,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIssueSecuritySchemes,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the issue security scheme.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the issue security scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the issue security scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_security_level_id",
+				Description: "The ID of the default security level, applied to new issues.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DefaultSecurityLevelID").NullIfZero(),
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the issue security scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+			{
+				Name:        "levels",
+				Description: "The security levels of the issue security scheme.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueSecuritySchemes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_security_scheme.listIssueSecuritySchemes", "connection_error", err)
+		return nil, err
+	}
+
+	req, err := client.NewRequest("GET", "rest/api/3/issuesecurityschemes", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_security_scheme.listIssueSecuritySchemes", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListIssueSecuritySchemeResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_security_scheme.listIssueSecuritySchemes", "api_error", err)
+		return nil, err
+	}
+
+	for _, scheme := range listResult.IssueSecuritySchemes {
+		d.StreamListItem(ctx, scheme)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIssueSecurityScheme(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	schemeId := d.EqualsQuals["id"].GetInt64Value()
+	if schemeId == 0 {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_security_scheme.getIssueSecurityScheme", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/issuesecurityschemes/%d", schemeId)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_security_scheme.getIssueSecurityScheme", "get_request_error", err)
+		return nil, err
+	}
+
+	scheme := new(IssueSecurityScheme)
+	_, err = client.Do(req, scheme)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_security_scheme.getIssueSecurityScheme", "api_error", err)
+		return nil, err
+	}
+
+	return *scheme, nil
+}
+
+//// Custom Structs
+
+type ListIssueSecuritySchemeResult struct {
+	IssueSecuritySchemes []IssueSecurityScheme `json:"issueSecuritySchemes"`
+}
+
+type IssueSecurityScheme struct {
+	ID                     int64             `json:"id"`
+	Self                   string            `json:"self"`
+	Name                   string            `json:"name"`
+	Description            string            `json:"description"`
+	DefaultSecurityLevelID int64             `json:"defaultSecurityLevelId"`
+	Levels                 []V3SecurityLevel `json:"levels"`
+}
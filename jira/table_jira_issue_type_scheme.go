@@ -0,0 +1,403 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueTypeScheme(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_type_scheme",
+		Description: "Issue type schemes define the issue types available in the projects they are associated with.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getIssueTypeScheme,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIssueTypeSchemes,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the issue type scheme.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the issue type scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the issue type scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_issue_type_id",
+				Description: "The ID of the default issue type of the issue type scheme.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DefaultIssueTypeID").NullIfZero(),
+			},
+			{
+				Name:        "is_default",
+				Description: "Whether the issue type scheme is the default.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// JSON fields
+			{
+				Name:        "issue_type_ids",
+				Description: "The IDs of the issue types in the issue type scheme.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIssueTypeSchemeIssueTypeIds,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "project_ids",
+				Description: "The IDs of the projects the issue type scheme is associated with.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIssueTypeSchemeProjectIds,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueTypeSchemes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_type_scheme.listIssueTypeSchemes", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 50
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 50 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/issuetypescheme?expand=projects,issueTypes&startAt=%d&maxResults=%d", last, maxResults)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type_scheme.listIssueTypeSchemes", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListIssueTypeSchemeResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type_scheme.listIssueTypeSchemes", "api_error", err)
+			return nil, err
+		}
+
+		for _, scheme := range listResult.Values {
+			d.StreamListItem(ctx, scheme)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIssueTypeScheme(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	schemeId := d.EqualsQualString("id")
+	if schemeId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_type_scheme.getIssueTypeScheme", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/issuetypescheme?expand=projects,issueTypes&id=%s", url.QueryEscape(schemeId))
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_type_scheme.getIssueTypeScheme", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListIssueTypeSchemeResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_type_scheme.getIssueTypeScheme", "api_error", err)
+		return nil, err
+	}
+
+	if len(listResult.Values) > 0 {
+		return listResult.Values[0], nil
+	}
+	return nil, nil
+}
+
+// getIssueTypeSchemeIssueTypeIds returns the expanded issue types of the
+// scheme, paging through the issue type mappings when the expansion was
+// truncated.
+func getIssueTypeSchemeIssueTypeIds(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	scheme := h.Item.(IssueTypeScheme)
+	if scheme.IssueTypes.complete() {
+		return scheme.IssueTypes.ids(), nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_type_scheme.getIssueTypeSchemeIssueTypeIds", "connection_error", err)
+		return nil, err
+	}
+
+	var ids []string
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/issuetypescheme/mapping?issueTypeSchemeId=%s&startAt=%d&maxResults=50", url.QueryEscape(scheme.ID), last)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type_scheme.getIssueTypeSchemeIssueTypeIds", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListIssueTypeSchemeMappingResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type_scheme.getIssueTypeSchemeIssueTypeIds", "api_error", err)
+			return nil, err
+		}
+
+		for _, mapping := range listResult.Values {
+			ids = append(ids, mapping.IssueTypeID)
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return ids, nil
+		}
+	}
+}
+
+// getIssueTypeSchemeProjectIds returns the expanded projects of the scheme,
+// falling back to the project to scheme mappings when the expansion was
+// truncated.
+func getIssueTypeSchemeProjectIds(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	scheme := h.Item.(IssueTypeScheme)
+	if scheme.Projects.complete() {
+		return scheme.Projects.ids(), nil
+	}
+
+	projects, err := getIssueTypeSchemeProjects(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return projects[scheme.ID], nil
+}
+
+// getIssueTypeSchemeProjects returns the IDs of the projects using each issue
+// type scheme, keyed by scheme ID. The mappings can only be looked up by
+// project, so every project is listed first. The result is cached as it is
+// shared by all the rows of the table.
+func getIssueTypeSchemeProjects(ctx context.Context, d *plugin.QueryData) (map[string][]string, error) {
+	cacheKey := "issue_type_scheme_projects"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(map[string][]string), nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_type_scheme.getIssueTypeSchemeProjects", "connection_error", err)
+		return nil, err
+	}
+
+	var projectIds []string
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/project/search?startAt=%d&maxResults=100", last)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type_scheme.getIssueTypeSchemeProjects", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ProjectListResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type_scheme.getIssueTypeSchemeProjects", "api_error", err)
+			return nil, err
+		}
+
+		for _, project := range listResult.Values {
+			projectIds = append(projectIds, project.ID)
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			break
+		}
+	}
+
+	projects := map[string][]string{}
+
+	// The endpoint accepts at most 100 project IDs per request
+	for len(projectIds) > 0 {
+		batch := projectIds
+		if len(batch) > 100 {
+			batch = batch[:100]
+		}
+		projectIds = projectIds[len(batch):]
+
+		query := url.Values{}
+		for _, id := range batch {
+			query.Add("projectId", id)
+		}
+
+		last := 0
+		for {
+			apiEndpoint := fmt.Sprintf("rest/api/3/issuetypescheme/project?%s&startAt=%d&maxResults=50", query.Encode(), last)
+
+			req, err := client.NewRequest("GET", apiEndpoint, nil)
+			if err != nil {
+				plugin.Logger(ctx).Error("jira_issue_type_scheme.getIssueTypeSchemeProjects", "get_request_error", err)
+				return nil, err
+			}
+
+			listResult := new(ListIssueTypeSchemeProjectsResult)
+			_, err = client.Do(req, listResult)
+			if err != nil {
+				plugin.Logger(ctx).Error("jira_issue_type_scheme.getIssueTypeSchemeProjects", "api_error", err)
+				return nil, err
+			}
+
+			for _, mapping := range listResult.Values {
+				schemeId := mapping.IssueTypeScheme.ID
+				projects[schemeId] = append(projects[schemeId], mapping.ProjectIds...)
+			}
+
+			last = listResult.StartAt + len(listResult.Values)
+			if listResult.IsLast || len(listResult.Values) == 0 {
+				break
+			}
+		}
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, projects)
+
+	return projects, nil
+}
+
+//// Custom Structs
+
+type ListIssueTypeSchemeResult struct {
+	Self       string            `json:"self"`
+	NextPage   string            `json:"nextPage"`
+	MaxResults int               `json:"maxResults"`
+	StartAt    int               `json:"startAt"`
+	Total      int               `json:"total"`
+	IsLast     bool              `json:"isLast"`
+	Values     []IssueTypeScheme `json:"values"`
+}
+
+type IssueTypeScheme struct {
+	ID                 string      `json:"id"`
+	Name               string      `json:"name"`
+	Description        string      `json:"description"`
+	DefaultIssueTypeID string      `json:"defaultIssueTypeId"`
+	IsDefault          bool        `json:"isDefault"`
+	IssueTypes         SchemeItems `json:"issueTypes"`
+	Projects           SchemeItems `json:"projects"`
+}
+
+// SchemeItems is the page of projects, issue types or priorities expanded
+// on a scheme
+type SchemeItems struct {
+	MaxResults int          `json:"maxResults"`
+	StartAt    int          `json:"startAt"`
+	Total      int          `json:"total"`
+	IsLast     bool         `json:"isLast"`
+	Values     []SchemeItem `json:"values"`
+}
+
+// complete reports whether the expansion holds every item, so no further
+// pages need to be requested.
+func (items SchemeItems) complete() bool {
+	return items.IsLast || len(items.Values) >= items.Total
+}
+
+func (items SchemeItems) ids() []string {
+	var ids []string
+	for _, item := range items.Values {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+type SchemeItem struct {
+	ID   string `json:"id"`
+	Key  string `json:"key,omitempty"`
+	Name string `json:"name"`
+}
+
+type ListIssueTypeSchemeMappingResult struct {
+	MaxResults int                      `json:"maxResults"`
+	StartAt    int                      `json:"startAt"`
+	Total      int                      `json:"total"`
+	IsLast     bool                     `json:"isLast"`
+	Values     []IssueTypeSchemeMapping `json:"values"`
+}
+
+type IssueTypeSchemeMapping struct {
+	IssueTypeSchemeID string `json:"issueTypeSchemeId"`
+	IssueTypeID       string `json:"issueTypeId"`
+}
+
+type ListIssueTypeSchemeProjectsResult struct {
+	MaxResults int                       `json:"maxResults"`
+	StartAt    int                       `json:"startAt"`
+	Total      int                       `json:"total"`
+	IsLast     bool                      `json:"isLast"`
+	Values     []IssueTypeSchemeProjects `json:"values"`
+}
+
+type IssueTypeSchemeProjects struct {
+	IssueTypeScheme IssueTypeScheme `json:"issueTypeScheme"`
+	ProjectIds      []string        `json:"projectIds"`
+}
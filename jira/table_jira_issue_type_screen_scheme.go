@@ -0,0 +1,275 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableIssueTypeScreenScheme(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_issue_type_screen_scheme",
+		Description: "Issue type screen schemes define the screen scheme used for each issue type in the projects they are associated with.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getIssueTypeScreenScheme,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listIssueTypeScreenSchemes,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the issue type screen scheme.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the issue type screen scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the issue type screen scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+			{
+				Name:        "issue_type_mappings",
+				Description: "The issue type to screen scheme mappings, where each key is an issue type ID, or default for unmapped issue types, and each value is a screen scheme ID.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIssueTypeScreenSchemeMappings,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "project_ids",
+				Description: "The IDs of the projects the issue type screen scheme is associated with.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getIssueTypeScreenSchemeProjectIds,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIssueTypeScreenSchemes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_type_screen_scheme.listIssueTypeScreenSchemes", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 100
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 100 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/issuetypescreenscheme?expand=projects&startAt=%d&maxResults=%d", last, maxResults)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type_screen_scheme.listIssueTypeScreenSchemes", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListIssueTypeScreenSchemeResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type_screen_scheme.listIssueTypeScreenSchemes", "api_error", err)
+			return nil, err
+		}
+
+		for _, scheme := range listResult.Values {
+			d.StreamListItem(ctx, scheme)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getIssueTypeScreenScheme(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	schemeId := d.EqualsQualString("id")
+	if schemeId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_type_screen_scheme.getIssueTypeScreenScheme", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/issuetypescreenscheme?expand=projects&id=%s", url.QueryEscape(schemeId))
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_type_screen_scheme.getIssueTypeScreenScheme", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListIssueTypeScreenSchemeResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_issue_type_screen_scheme.getIssueTypeScreenScheme", "api_error", err)
+		return nil, err
+	}
+
+	if len(listResult.Values) > 0 {
+		return listResult.Values[0], nil
+	}
+	return nil, nil
+}
+
+func getIssueTypeScreenSchemeMappings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	scheme := h.Item.(IssueTypeScreenScheme)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_type_screen_scheme.getIssueTypeScreenSchemeMappings", "connection_error", err)
+		return nil, err
+	}
+
+	mappings := map[string]string{}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf(
+			"rest/api/3/issuetypescreenscheme/mapping?issueTypeScreenSchemeId=%s&startAt=%d&maxResults=100",
+			url.QueryEscape(scheme.ID),
+			last,
+		)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type_screen_scheme.getIssueTypeScreenSchemeMappings", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListIssueTypeScreenSchemeMappingResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type_screen_scheme.getIssueTypeScreenSchemeMappings", "api_error", err)
+			return nil, err
+		}
+
+		for _, mapping := range listResult.Values {
+			mappings[mapping.IssueTypeID] = mapping.ScreenSchemeID
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return mappings, nil
+		}
+	}
+}
+
+// getIssueTypeScreenSchemeProjectIds returns the expanded projects of the
+// scheme, paging through the rest when the expansion was truncated.
+func getIssueTypeScreenSchemeProjectIds(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	scheme := h.Item.(IssueTypeScreenScheme)
+	if scheme.Projects.complete() {
+		return scheme.Projects.ids(), nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_issue_type_screen_scheme.getIssueTypeScreenSchemeProjectIds", "connection_error", err)
+		return nil, err
+	}
+
+	var ids []string
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/issuetypescreenscheme/%s/project?startAt=%d&maxResults=50", url.PathEscape(scheme.ID), last)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type_screen_scheme.getIssueTypeScreenSchemeProjectIds", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(SchemeItems)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_issue_type_screen_scheme.getIssueTypeScreenSchemeProjectIds", "api_error", err)
+			return nil, err
+		}
+
+		ids = append(ids, listResult.ids()...)
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return ids, nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type ListIssueTypeScreenSchemeResult struct {
+	Self       string                  `json:"self"`
+	NextPage   string                  `json:"nextPage"`
+	MaxResults int                     `json:"maxResults"`
+	StartAt    int                     `json:"startAt"`
+	Total      int                     `json:"total"`
+	IsLast     bool                    `json:"isLast"`
+	Values     []IssueTypeScreenScheme `json:"values"`
+}
+
+type IssueTypeScreenScheme struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Projects    SchemeItems `json:"projects"`
+}
+
+type ListIssueTypeScreenSchemeMappingResult struct {
+	MaxResults int  `json:"maxResults"`
+	StartAt    int  `json:"startAt"`
+	Total      int  `json:"total"`
+	IsLast     bool `json:"isLast"`
+	Values     []struct {
+		IssueTypeScreenSchemeID string `json:"issueTypeScreenSchemeId"`
+		IssueTypeID             string `json:"issueTypeId"`
+		ScreenSchemeID          string `json:"screenSchemeId"`
+	} `json:"values"`
+}
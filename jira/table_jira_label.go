@@ -0,0 +1,102 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableLabel(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_label",
+		Description: "Labels are keywords that can be added to issues to categorize them.",
+		List: &plugin.ListConfig{
+			Hydrate: listLabels,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the label.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listLabels(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_label.listLabels", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 1000
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 1000 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/label?startAt=%d&maxResults=%d", last, maxResults)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_label.listLabels", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListLabelResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_label.listLabels", "api_error", err)
+			return nil, err
+		}
+
+		for _, label := range listResult.Values {
+			d.StreamListItem(ctx, label)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type ListLabelResult struct {
+	Self       string   `json:"self"`
+	NextPage   string   `json:"nextPage"`
+	MaxResults int      `json:"maxResults"`
+	StartAt    int      `json:"startAt"`
+	Total      int      `json:"total"`
+	IsLast     bool     `json:"isLast"`
+	Values     []string `json:"values"`
+}
@@ -0,0 +1,238 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableNotificationScheme(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_notification_scheme",
+		Description: "Notification schemes define who is emailed on issue events, with one row per scheme, event and recipient.",
+		List: &plugin.ListConfig{
+			Hydrate: listNotificationSchemes,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the notification scheme.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SchemeId"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the notification scheme.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SchemeName"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the notification scheme.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SchemeDescription"),
+			},
+			{
+				Name:        "event_id",
+				Description: "The ID of the issue event, for example 1 for Issue Created.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Event.ID"),
+			},
+			{
+				Name:        "event_name",
+				Description: "The name of the issue event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Event.Name"),
+			},
+			{
+				Name:        "notification_id",
+				Description: "The ID of the notification.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Notification.ID"),
+			},
+			{
+				Name:        "notification_type",
+				Description: "The type of the recipient, for example CurrentAssignee, Reporter, Group, ProjectRole, User or EmailAddress.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Notification.NotificationType"),
+			},
+			{
+				Name:        "parameter",
+				Description: "The identifier associated with the notification type, such as the group name, project role ID or email address.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Notification.Parameter").NullIfZero(),
+			},
+			{
+				Name:        "recipient",
+				Description: "A human readable description of the recipient, such as the user display name, group name or project role name.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Notification").Transform(notificationRecipientName),
+			},
+
+			// JSON fields
+			{
+				Name:        "notification",
+				Description: "The details of the notification recipient.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNotificationSchemes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_notification_scheme.listNotificationSchemes", "connection_error", err)
+		return nil, err
+	}
+
+	query := ""
+	if d.EqualsQuals["id"] != nil {
+		query = fmt.Sprintf("&id=%d", d.EqualsQuals["id"].GetInt64Value())
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/notificationscheme?expand=all&startAt=%d&maxResults=50%s", last, query)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_notification_scheme.listNotificationSchemes", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListNotificationSchemeResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_notification_scheme.listNotificationSchemes", "api_error", err)
+			return nil, err
+		}
+
+		for _, scheme := range listResult.Values {
+			for _, event := range scheme.NotificationSchemeEvents {
+				for _, notification := range event.Notifications {
+					d.StreamListItem(ctx, NotificationSchemeRecipient{
+						SchemeId:          scheme.ID,
+						SchemeName:        scheme.Name,
+						SchemeDescription: scheme.Description,
+						Event:             event.Event,
+						Notification:      notification,
+					})
+					// Context may get cancelled due to manual cancellation or if the limit has been reached
+					if d.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// TRANSFORM FUNCTION
+
+func notificationRecipientName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	notification := d.Value.(Notification)
+
+	switch notification.NotificationType {
+	case "User":
+		if notification.User != nil && notification.User.DisplayName != "" {
+			return notification.User.DisplayName, nil
+		}
+	case "Group":
+		if notification.Group != nil && notification.Group.Name != "" {
+			return notification.Group.Name, nil
+		}
+	case "ProjectRole":
+		if notification.ProjectRole != nil && notification.ProjectRole.Name != "" {
+			return notification.ProjectRole.Name, nil
+		}
+	case "UserCustomField", "GroupCustomField":
+		if name, ok := notification.Field["name"].(string); ok && name != "" {
+			return name, nil
+		}
+	case "EmailAddress":
+		if notification.EmailAddress != "" {
+			return notification.EmailAddress, nil
+		}
+	case "CurrentAssignee":
+		return "Current assignee", nil
+	case "Reporter":
+		return "Reporter", nil
+	case "CurrentUser":
+		return "Current user", nil
+	case "ProjectLead":
+		return "Project lead", nil
+	case "ComponentLead":
+		return "Component lead", nil
+	case "AllWatchers":
+		return "All watchers", nil
+	}
+	return notification.Parameter, nil
+}
+
+//// Custom Structs
+
+type ListNotificationSchemeResult struct {
+	Self       string               `json:"self"`
+	NextPage   string               `json:"nextPage"`
+	MaxResults int                  `json:"maxResults"`
+	StartAt    int                  `json:"startAt"`
+	Total      int                  `json:"total"`
+	IsLast     bool                 `json:"isLast"`
+	Values     []NotificationScheme `json:"values"`
+}
+
+type NotificationScheme struct {
+	ID                       int64                     `json:"id"`
+	Self                     string                    `json:"self"`
+	Name                     string                    `json:"name"`
+	Description              string                    `json:"description"`
+	NotificationSchemeEvents []NotificationSchemeEvent `json:"notificationSchemeEvents"`
+}
+
+type NotificationSchemeEvent struct {
+	Event         NotificationEvent `json:"event"`
+	Notifications []Notification    `json:"notifications"`
+}
+
+type NotificationEvent struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type Notification struct {
+	ID               int64                  `json:"id"`
+	NotificationType string                 `json:"notificationType"`
+	Parameter        string                 `json:"parameter,omitempty"`
+	Recipient        string                 `json:"recipient,omitempty"`
+	EmailAddress     string                 `json:"emailAddress,omitempty"`
+	User             *V3User                `json:"user,omitempty"`
+	Group            *Group                 `json:"group,omitempty"`
+	Field            map[string]interface{} `json:"field,omitempty"`
+	ProjectRole      *PermissionHolderRole  `json:"projectRole,omitempty"`
+}
+
+type NotificationSchemeRecipient struct {
+	SchemeId          int64
+	SchemeName        string
+	SchemeDescription string
+	Event             NotificationEvent
+	Notification      Notification
+}
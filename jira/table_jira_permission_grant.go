@@ -0,0 +1,173 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tablePermissionGrant(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_permission_grant",
+		Description: "Permission grants of all permission schemes, with one row per scheme, permission and holder.",
+		List: &plugin.ListConfig{
+			Hydrate: listPermissionGrants,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "scheme_id", Require: plugin.Optional},
+				{Name: "permission", Require: plugin.Optional},
+				{Name: "holder_type", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the permission grant.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "scheme_id",
+				Description: "The ID of the permission scheme the grant belongs to.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "scheme_name",
+				Description: "The name of the permission scheme the grant belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "permission",
+				Description: "The key of the permission granted, for example BROWSE_PROJECTS, EDIT_ISSUES or DELETE_ISSUES.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "holder_type",
+				Description: "The type of the permission holder, for example user, group, projectRole, applicationRole, anyone, reporter or assignee.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Holder.Type"),
+			},
+			{
+				Name:        "holder_parameter",
+				Description: "The identifier associated with the holder type, such as the group name, project role ID or account ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Holder.Parameter").NullIfZero(),
+			},
+			{
+				Name:        "holder_value",
+				Description: "The value associated with the holder type, such as the group ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Holder.Value").NullIfZero(),
+			},
+			{
+				Name:        "holder_display",
+				Description: "A human readable description of the permission holder, such as the user display name, group name or project role name.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Holder").Transform(permissionHolderDisplayName),
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the permission grant.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+			{
+				Name:        "holder",
+				Description: "The details of the permission holder.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPermissionGrants(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	schemes, err := getPermissionSchemes(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	schemeId := d.EqualsQuals["scheme_id"].GetInt64Value()
+	permission := d.EqualsQualString("permission")
+	holderType := d.EqualsQualString("holder_type")
+
+	for _, scheme := range schemes {
+		if schemeId != 0 && scheme.ID != schemeId {
+			continue
+		}
+		for _, grant := range scheme.Permissions {
+			if permission != "" && grant.Permission != permission {
+				continue
+			}
+			if holderType != "" && grant.Holder.Type != holderType {
+				continue
+			}
+
+			d.StreamListItem(ctx, PermissionGrantInfo{grant, scheme.ID, scheme.Name})
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func permissionHolderDisplayName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return permissionHolderDisplay(d.Value.(PermissionHolder)), nil
+}
+
+// permissionHolderDisplay describes a permission holder the way it is shown
+// on the permission scheme page in Jira
+func permissionHolderDisplay(holder PermissionHolder) string {
+	switch holder.Type {
+	case "user":
+		if holder.User != nil && holder.User.DisplayName != "" {
+			return holder.User.DisplayName
+		}
+	case "group":
+		if holder.Group != nil && holder.Group.Name != "" {
+			return holder.Group.Name
+		}
+	case "projectRole":
+		if holder.ProjectRole != nil && holder.ProjectRole.Name != "" {
+			return holder.ProjectRole.Name
+		}
+	case "userCustomField", "groupCustomField":
+		if name, ok := holder.Field["name"].(string); ok && name != "" {
+			return name
+		}
+	case "applicationRole":
+		if holder.Parameter == "" {
+			return "Any logged in user"
+		}
+	case "anyone":
+		return "Public"
+	case "reporter":
+		return "Reporter"
+	case "assignee":
+		return "Current assignee"
+	case "projectLead":
+		return "Project lead"
+	case "sd.customer.portal.only":
+		return "Service project customer - portal access"
+	}
+	return holder.Parameter
+}
+
+//// Custom Structs
+
+type PermissionGrantInfo struct {
+	PermissionGrant
+	SchemeId   int64
+	SchemeName string
+}
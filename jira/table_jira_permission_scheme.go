@@ -0,0 +1,195 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tablePermissionScheme(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_permission_scheme",
+		Description: "Permission schemes define who can perform which actions, such as browsing, editing or deleting issues, in the projects they are associated with.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getPermissionScheme,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listPermissionSchemes,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the permission scheme.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the permission scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of the permission scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the permission scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+			{
+				Name:        "scope",
+				Description: "The scope of the permission scheme.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "permissions",
+				Description: "The permission grants of the permission scheme.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPermissionSchemes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	schemes, err := getPermissionSchemes(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, scheme := range schemes {
+		d.StreamListItem(ctx, scheme)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPermissionScheme(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	schemeId := d.EqualsQuals["id"].GetInt64Value()
+	if schemeId == 0 {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_permission_scheme.getPermissionScheme", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/permissionscheme/%d?expand=all", schemeId)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_permission_scheme.getPermissionScheme", "get_request_error", err)
+		return nil, err
+	}
+
+	scheme := new(PermissionScheme)
+	_, err = client.Do(req, scheme)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_permission_scheme.getPermissionScheme", "api_error", err)
+		return nil, err
+	}
+
+	return *scheme, nil
+}
+
+// getPermissionSchemes returns all permission schemes with their grants and
+// expanded holders. The result is cached as it is shared by the permission
+// grant and effective permission tables.
+func getPermissionSchemes(ctx context.Context, d *plugin.QueryData) ([]PermissionScheme, error) {
+	cacheKey := "permission_schemes"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]PermissionScheme), nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_permission_scheme.getPermissionSchemes", "connection_error", err)
+		return nil, err
+	}
+
+	req, err := client.NewRequest("GET", "rest/api/3/permissionscheme?expand=all", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_permission_scheme.getPermissionSchemes", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListPermissionSchemeResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_permission_scheme.getPermissionSchemes", "api_error", err)
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, listResult.PermissionSchemes)
+
+	return listResult.PermissionSchemes, nil
+}
+
+//// Custom Structs
+
+type ListPermissionSchemeResult struct {
+	PermissionSchemes []PermissionScheme `json:"permissionSchemes"`
+}
+
+type PermissionScheme struct {
+	ID          int64                  `json:"id"`
+	Self        string                 `json:"self"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Scope       map[string]interface{} `json:"scope,omitempty"`
+	Permissions []PermissionGrant      `json:"permissions"`
+}
+
+type PermissionGrant struct {
+	ID         int64            `json:"id"`
+	Self       string           `json:"self"`
+	Holder     PermissionHolder `json:"holder"`
+	Permission string           `json:"permission"`
+}
+
+type PermissionHolder struct {
+	Type        string                 `json:"type"`
+	Parameter   string                 `json:"parameter,omitempty"`
+	Value       string                 `json:"value,omitempty"`
+	Expand      string                 `json:"expand,omitempty"`
+	User        *V3User                `json:"user,omitempty"`
+	Group       *Group                 `json:"group,omitempty"`
+	Field       map[string]interface{} `json:"field,omitempty"`
+	ProjectRole *PermissionHolderRole  `json:"projectRole,omitempty"`
+}
+
+type PermissionHolderRole struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,255 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tablePriorityScheme(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_priority_scheme",
+		Description: "Priority schemes define the priorities available in the projects they are associated with.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getPriorityScheme,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listPrioritySchemes,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the priority scheme.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the priority scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the priority scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_priority_id",
+				Description: "The ID of the default priority of the priority scheme.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DefaultPriorityID").NullIfZero(),
+			},
+			{
+				Name:        "is_default",
+				Description: "Whether the priority scheme is the default.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the priority scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+			{
+				Name:        "priority_ids",
+				Description: "The IDs of the priorities in the priority scheme.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPrioritySchemePriorityIds,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "project_ids",
+				Description: "The IDs of the projects the priority scheme is associated with.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPrioritySchemeProjectIds,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPrioritySchemes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_priority_scheme.listPrioritySchemes", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 50
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 50 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/priorityscheme?expand=priorities,projects&startAt=%d&maxResults=%d", last, maxResults)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_priority_scheme.listPrioritySchemes", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListPrioritySchemeResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_priority_scheme.listPrioritySchemes", "api_error", err)
+			return nil, err
+		}
+
+		for _, scheme := range listResult.Values {
+			d.StreamListItem(ctx, scheme)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPriorityScheme(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	schemeId := d.EqualsQualString("id")
+	if schemeId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_priority_scheme.getPriorityScheme", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/priorityscheme?expand=priorities,projects&schemeId=%s", url.QueryEscape(schemeId))
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_priority_scheme.getPriorityScheme", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListPrioritySchemeResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_priority_scheme.getPriorityScheme", "api_error", err)
+		return nil, err
+	}
+
+	if len(listResult.Values) > 0 {
+		return listResult.Values[0], nil
+	}
+	return nil, nil
+}
+
+// getPrioritySchemePriorityIds returns the expanded priorities of the scheme,
+// paging through the rest when the expansion was truncated.
+func getPrioritySchemePriorityIds(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	scheme := h.Item.(PriorityScheme)
+	if scheme.Priorities.complete() {
+		return scheme.Priorities.ids(), nil
+	}
+	return listPrioritySchemeItemIds(ctx, d, "getPrioritySchemePriorityIds", fmt.Sprintf("rest/api/3/priorityscheme/%s/priorities", url.PathEscape(scheme.ID)))
+}
+
+// getPrioritySchemeProjectIds returns the expanded projects of the scheme,
+// paging through the rest when the expansion was truncated.
+func getPrioritySchemeProjectIds(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	scheme := h.Item.(PriorityScheme)
+	if scheme.Projects.complete() {
+		return scheme.Projects.ids(), nil
+	}
+	return listPrioritySchemeItemIds(ctx, d, "getPrioritySchemeProjectIds", fmt.Sprintf("rest/api/3/priorityscheme/%s/projects", url.PathEscape(scheme.ID)))
+}
+
+//// UTILITY FUNCTIONS
+
+func listPrioritySchemeItemIds(ctx context.Context, d *plugin.QueryData, caller string, endpoint string) ([]string, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_priority_scheme."+caller, "connection_error", err)
+		return nil, err
+	}
+
+	var ids []string
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("%s?startAt=%d&maxResults=50", endpoint, last)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_priority_scheme."+caller, "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(SchemeItems)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_priority_scheme."+caller, "api_error", err)
+			return nil, err
+		}
+
+		ids = append(ids, listResult.ids()...)
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return ids, nil
+		}
+	}
+}
+
+//// Custom Structs
+
+type ListPrioritySchemeResult struct {
+	Self       string           `json:"self"`
+	NextPage   string           `json:"nextPage"`
+	MaxResults int              `json:"maxResults"`
+	StartAt    int              `json:"startAt"`
+	Total      int              `json:"total"`
+	IsLast     bool             `json:"isLast"`
+	Values     []PriorityScheme `json:"values"`
+}
+
+type PriorityScheme struct {
+	ID                string      `json:"id"`
+	Self              string      `json:"self"`
+	Name              string      `json:"name"`
+	Description       string      `json:"description"`
+	DefaultPriorityID string      `json:"defaultPriorityId"`
+	IsDefault         bool        `json:"isDefault"`
+	Priorities        SchemeItems `json:"priorities"`
+	Projects          SchemeItems `json:"projects"`
+}
@@ -2,8 +2,10 @@ package jira
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
 
 	"github.com/andygrunwald/go-jira"
@@ -85,6 +87,20 @@ func tableProject(_ context.Context) *plugin.Table {
 				Description: "The project type of the project. Valid values are software, service_desk and business.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "permission_scheme_id",
+				Description: "The ID of the permission scheme associated with the project.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getProjectPermissionScheme,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "default_classification_level",
+				Description: "The name of the default data classification level of issues in the project.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getProjectDefaultClassificationLevel,
+				Transform:   transform.FromField("Name"),
+			},
 			{
 				Name:        "url",
 				Description: "A link to information about this project, such as project documentation.",
@@ -280,6 +296,110 @@ func getProjectProperties(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	return properties, nil
 }
 
+func getProjectPermissionScheme(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := getProjectInfo(ctx, h.Item)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getProjectPermissionScheme", "connection_error", err)
+		return nil, err
+	}
+
+	scheme, err := getPermissionSchemeForProject(ctx, client, project.ID)
+	if err != nil || scheme == nil {
+		return nil, err
+	}
+
+	return *scheme, nil
+}
+
+func getProjectDefaultClassificationLevel(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := getProjectInfo(ctx, h.Item)
+
+	// Data classification is only available in Jira Cloud
+	cloud, err := isJiraCloud(ctx, d)
+	if err != nil || !cloud {
+		return nil, err
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getProjectDefaultClassificationLevel", "connection_error", err)
+		return nil, err
+	}
+
+	level, err := getDefaultClassificationLevelForProject(ctx, client, project.ID)
+	if err != nil || level == nil {
+		return nil, err
+	}
+
+	return *level, nil
+}
+
+// getDefaultClassificationLevelForProject returns the default classification
+// level of a project, or nil if the project has none.
+func getDefaultClassificationLevelForProject(ctx context.Context, client *jira.Client, projectId string) (*ClassificationLevel, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/classification-level/default", projectId)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getDefaultClassificationLevelForProject", "get_request_error", err)
+		return nil, err
+	}
+
+	// The body is decoded here, as projects without a default classification
+	// level return 204 with no content, which client.Do would fail to decode
+	res, err := client.Do(req, nil)
+	if err != nil {
+		if isNotFoundError(err) || isForbiddenError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_project.getDefaultClassificationLevelForProject", "api_error", err)
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, nil
+	}
+
+	level := new(ClassificationLevel)
+	if err := json.NewDecoder(res.Body).Decode(level); err != nil {
+		plugin.Logger(ctx).Error("jira_project.getDefaultClassificationLevelForProject", "decode_error", err)
+		return nil, err
+	}
+
+	if level.ID == "" {
+		return nil, nil
+	}
+
+	return level, nil
+}
+
+func getPermissionSchemeForProject(ctx context.Context, client *jira.Client, projectId string) (*PermissionScheme, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/permissionscheme", projectId)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project.getPermissionSchemeForProject", "get_request_error", err)
+		return nil, err
+	}
+
+	scheme := new(PermissionScheme)
+	_, err = client.Do(req, scheme)
+	if err != nil {
+		// Reading the permission scheme requires the Administer Projects
+		// permission, which the connection user may not have for every project
+		if isNotFoundError(err) || isForbiddenError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_project.getPermissionSchemeForProject", "api_error", err)
+		return nil, err
+	}
+
+	return scheme, nil
+}
+
 func getProjectPropertyKeys(ctx context.Context, client *jira.Client, projectId string) ([]ProjectKey, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/properties", projectId)
 
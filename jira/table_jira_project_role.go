@@ -2,6 +2,7 @@ package jira
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"github.com/andygrunwald/go-jira"
@@ -127,6 +128,43 @@ func getProjectRole(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 	return *role, err
 }
 
+// getProjectRoleActors returns the users and groups that act in a project
+// role for a specific project
+func getProjectRoleActors(ctx context.Context, d *plugin.QueryData, projectId string, roleId int64) (*ProjectRoleActors, error) {
+	cacheKey := fmt.Sprintf("project_role_actors_%s_%d", projectId, roleId)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*ProjectRoleActors), nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_role.getProjectRoleActors", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/role/%d", projectId, roleId)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_role.getProjectRoleActors", "get_request_error", err)
+		return nil, err
+	}
+
+	role := new(ProjectRoleActors)
+	_, err = client.Do(req, role)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_project_role.getProjectRoleActors", "api_error", err)
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, role)
+
+	return role, nil
+}
+
 //// TRANSFORM FUNCTION
 
 func extractActorAccountIds(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -144,3 +182,27 @@ func extractActorNames(_ context.Context, d *transform.TransformData) (interface
 	}
 	return actorNames, nil
 }
+
+//// Custom Structs
+
+type ProjectRoleActors struct {
+	ID          int64       `json:"id"`
+	Self        string      `json:"self"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Actors      []RoleActor `json:"actors"`
+}
+
+type RoleActor struct {
+	ID          int64  `json:"id"`
+	DisplayName string `json:"displayName"`
+	Type        string `json:"type"`
+	ActorUser   struct {
+		AccountID string `json:"accountId"`
+	} `json:"actorUser"`
+	ActorGroup struct {
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+		GroupId     string `json:"groupId"`
+	} `json:"actorGroup"`
+}
@@ -0,0 +1,186 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableProjectRoleActor(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_project_role_actor",
+		Description: "The users and groups that hold a project role in a specific project.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listProjectRoleActors,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "project_key", Require: plugin.Optional},
+				{Name: "role_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_key",
+				Description: "The key of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_id",
+				Description: "The ID of the project role.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "role_name",
+				Description: "The name of the project role.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the role actor.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Actor.ID"),
+			},
+			{
+				Name:        "actor_type",
+				Description: "The type of the role actor. Possible values are user and group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Actor.Type").Transform(roleActorType),
+			},
+			{
+				Name:        "account_id",
+				Description: "The account ID of the user, if the actor is a user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Actor.ActorUser.AccountID").NullIfZero(),
+			},
+			{
+				Name:        "group_id",
+				Description: "The ID of the group, if the actor is a group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Actor.ActorGroup.GroupId").NullIfZero(),
+			},
+			{
+				Name:        "group_name",
+				Description: "The name of the group, if the actor is a group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Actor.ActorGroup.Name").NullIfZero(),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the user or group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Actor.DisplayName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listProjectRoleActors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(Project)
+
+	if d.EqualsQualString("project_id") != "" && d.EqualsQualString("project_id") != project.ID {
+		return nil, nil
+	}
+	if d.EqualsQualString("project_key") != "" && d.EqualsQualString("project_key") != project.Key {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_role_actor.listProjectRoleActors", "connection_error", err)
+		return nil, err
+	}
+
+	// The roles of a project are returned as a map of role names to the URL
+	// of the role in the project, which ends with the role ID
+	apiEndpoint := fmt.Sprintf("rest/api/3/project/%s/role", project.ID)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_project_role_actor.listProjectRoleActors", "get_request_error", err)
+		return nil, err
+	}
+
+	roles := map[string]string{}
+	_, err = client.Do(req, &roles)
+	if err != nil {
+		if isNotFoundError(err) || isForbiddenError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_project_role_actor.listProjectRoleActors", "api_error", err)
+		return nil, err
+	}
+
+	roleIdQual := d.EqualsQuals["role_id"].GetInt64Value()
+	for _, roleURL := range roles {
+		roleId, err := strconv.ParseInt(path.Base(roleURL), 10, 64)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_project_role_actor.listProjectRoleActors", "parse_error", err, "url", roleURL)
+			continue
+		}
+		if roleIdQual != 0 && roleId != roleIdQual {
+			continue
+		}
+
+		role, err := getProjectRoleActors(ctx, d, project.ID, roleId)
+		if err != nil {
+			return nil, err
+		}
+		if role == nil {
+			continue
+		}
+
+		for _, actor := range role.Actors {
+			d.StreamListItem(ctx, ProjectRoleActorInfo{
+				ProjectId:  project.ID,
+				ProjectKey: project.Key,
+				RoleId:     role.ID,
+				RoleName:   role.Name,
+				Actor:      actor,
+			})
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func roleActorType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch d.Value.(string) {
+	case "atlassian-user-role-actor":
+		return "user", nil
+	case "atlassian-group-role-actor":
+		return "group", nil
+	}
+	return d.Value, nil
+}
+
+//// Custom Structs
+
+type ProjectRoleActorInfo struct {
+	ProjectId  string
+	ProjectKey string
+	RoleId     int64
+	RoleName   string
+	Actor      RoleActor
+}
@@ -0,0 +1,57 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func TestGetDefaultClassificationLevelForProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/project/10000/classification-level/default":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"ari:cloud:platform::classification-tag/1","name":"Confidential","status":"PUBLISHED"}`))
+		case "/rest/api/3/project/10001/classification-level/default":
+			// Projects without a default classification level
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := jira.NewClient(server.Client(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name      string
+		projectId string
+		want      string
+	}{
+		{name: "default level", projectId: "10000", want: "Confidential"},
+		{name: "no default level", projectId: "10001"},
+		{name: "project not found", projectId: "10002"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			level, err := getDefaultClassificationLevelForProject(context.Background(), client, c.projectId)
+			if err != nil {
+				t.Fatalf("getDefaultClassificationLevelForProject() error = %v", err)
+			}
+			got := ""
+			if level != nil {
+				got = level.Name
+			}
+			if got != c.want {
+				t.Errorf("getDefaultClassificationLevelForProject() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,373 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andygrunwald/go-jira"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tablePublicExposure(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_public_exposure",
+		Description: "Content and settings exposed to anonymous users or to all logged in users, with one finding per exposed object.",
+		List: &plugin.ListConfig{
+			Hydrate: listPublicExposures,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "exposure_scope", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "resource_type",
+				Description: "The type of the exposed resource. Possible values are permission_scheme, filter, dashboard and application_property.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the exposed resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the exposed resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "exposure_scope",
+				Description: "Who the resource is exposed to. Possible values are anonymous, for anyone on the web, and authenticated, for all logged in users.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access",
+				Description: "The access that is exposed, such as the permission key for permission schemes, or view and edit for filters and dashboards.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "share_type",
+				Description: "The share permission type, permission holder type or application property value that causes the exposure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "owner_account_id",
+				Description: "The account ID of the owner of the resource, for filters and dashboards.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OwnerAccountId").NullIfZero(),
+			},
+			{
+				Name:        "url",
+				Description: "A link to the resource in Jira.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("URL"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPublicExposures(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_public_exposure.listPublicExposures", "connection_error", err)
+		return nil, err
+	}
+
+	resourceType := d.EqualsQualString("resource_type")
+	exposureScope := d.EqualsQualString("exposure_scope")
+
+	scanners := []struct {
+		resourceType string
+		scan         func(context.Context, *plugin.QueryData, *jira.Client) ([]PublicExposure, error)
+	}{
+		{"permission_scheme", getPermissionSchemeExposures},
+		{"filter", getFilterExposures},
+		{"dashboard", getDashboardExposures},
+		{"application_property", getApplicationPropertyExposures},
+	}
+
+	for _, scanner := range scanners {
+		if resourceType != "" && resourceType != scanner.resourceType {
+			continue
+		}
+
+		exposures, err := scanner.scan(ctx, d, client)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, exposure := range exposures {
+			if exposureScope != "" && exposure.ExposureScope != exposureScope {
+				continue
+			}
+			d.StreamListItem(ctx, exposure)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPermissionSchemeExposures(ctx context.Context, d *plugin.QueryData, client *jira.Client) ([]PublicExposure, error) {
+	schemes, err := getPermissionSchemes(ctx, d)
+	if err != nil {
+		// Permission schemes can only be read by Jira administrators
+		if isForbiddenError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	exposures := []PublicExposure{}
+	for _, scheme := range schemes {
+		for _, grant := range scheme.Permissions {
+			scope := ""
+			switch {
+			case grant.Holder.Type == "anyone":
+				scope = "anonymous"
+			case grant.Holder.Type == "applicationRole" && grant.Holder.Parameter == "":
+				scope = "authenticated"
+			default:
+				continue
+			}
+
+			exposures = append(exposures, PublicExposure{
+				ResourceType:  "permission_scheme",
+				ResourceId:    fmt.Sprint(scheme.ID),
+				ResourceName:  scheme.Name,
+				ExposureScope: scope,
+				Access:        grant.Permission,
+				ShareType:     grant.Holder.Type,
+				URL:           jiraURL(client, fmt.Sprintf("secure/admin/EditPermissions!default.jspa?schemeId=%d", scheme.ID)),
+			})
+		}
+	}
+
+	return exposures, nil
+}
+
+func getFilterExposures(ctx context.Context, d *plugin.QueryData, client *jira.Client) ([]PublicExposure, error) {
+	exposures := []PublicExposure{}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/filter/search?expand=%s&startAt=%d&maxResults=100", filterExpand, last)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_public_exposure.getFilterExposures", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListFilterResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_public_exposure.getFilterExposures", "api_error", err)
+			return nil, err
+		}
+
+		for _, filter := range listResult.Values {
+			shares := []struct {
+				access      string
+				permissions []SharePermissionDetail
+			}{{"view", filter.SharePermissions}, {"edit", filter.EditPermissions}}
+			for _, share := range shares {
+				for _, permission := range share.permissions {
+					scope := shareExposureScope(permission.Type)
+					if scope == "" {
+						continue
+					}
+					exposures = append(exposures, PublicExposure{
+						ResourceType:   "filter",
+						ResourceId:     fmt.Sprint(filter.ID),
+						ResourceName:   filter.Name,
+						ExposureScope:  scope,
+						Access:         share.access,
+						ShareType:      permission.Type,
+						OwnerAccountId: filter.Owner.AccountID,
+						URL:            filter.ViewURL,
+					})
+				}
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return exposures, nil
+		}
+	}
+}
+
+func getDashboardExposures(ctx context.Context, _ *plugin.QueryData, client *jira.Client) ([]PublicExposure, error) {
+	exposures := []PublicExposure{}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/dashboard/search?expand=owner,viewUrl,sharePermissions,editPermissions&startAt=%d&maxResults=100", last)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_public_exposure.getDashboardExposures", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(SearchDashboardResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_public_exposure.getDashboardExposures", "api_error", err)
+			return nil, err
+		}
+
+		for _, dashboard := range listResult.Values {
+			shares := []struct {
+				access      string
+				permissions []SharePermission
+			}{{"view", dashboard.SharePermissions}, {"edit", dashboard.EditPermissions}}
+			for _, share := range shares {
+				for _, permission := range share.permissions {
+					scope := shareExposureScope(permission.Type)
+					if scope == "" {
+						continue
+					}
+					exposures = append(exposures, PublicExposure{
+						ResourceType:   "dashboard",
+						ResourceId:     dashboard.Id,
+						ResourceName:   dashboard.Name,
+						ExposureScope:  scope,
+						Access:         share.access,
+						ShareType:      permission.Type,
+						OwnerAccountId: dashboard.Owner.AccountID,
+						URL:            dashboard.View,
+					})
+				}
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return exposures, nil
+		}
+	}
+}
+
+func getApplicationPropertyExposures(ctx context.Context, _ *plugin.QueryData, client *jira.Client) ([]PublicExposure, error) {
+	req, err := client.NewRequest("GET", "rest/api/3/application-properties", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_public_exposure.getApplicationPropertyExposures", "get_request_error", err)
+		return nil, err
+	}
+
+	properties := []AdvancedApplicationProperty{}
+	_, err = client.Do(req, &properties)
+	if err != nil {
+		// Application properties can only be read by Jira administrators
+		if isNotFoundError(err) || isForbiddenError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_public_exposure.getApplicationPropertyExposures", "api_error", err)
+		return nil, err
+	}
+
+	exposures := []PublicExposure{}
+	for _, property := range properties {
+		setting, ok := publicSharingSetting(property)
+		if !ok {
+			continue
+		}
+		exposures = append(exposures, PublicExposure{
+			ResourceType:  "application_property",
+			ResourceId:    property.Key,
+			ResourceName:  property.Name,
+			ExposureScope: setting.Scope,
+			Access:        setting.Access,
+			ShareType:     property.Value,
+			URL:           jiraURL(client, "secure/admin/ViewApplicationProperties.jspa"),
+		})
+	}
+
+	return exposures, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// shareExposureScope returns who a filter or dashboard share permission
+// exposes the content to, or an empty string if it is not shared broadly
+func shareExposureScope(shareType string) string {
+	switch shareType {
+	case "global", "public":
+		return "anonymous"
+	case "loggedin", "authenticated":
+		return "authenticated"
+	}
+	return ""
+}
+
+// publicSharingSettings are the application properties that expose data
+// beyond the users of the site, with the value that causes the exposure
+var publicSharingSettings = []PublicSharingSetting{
+	// Public mode lets anyone on the web sign up for an account
+	{"jira.mode", "public", "anonymous", "signup"},
+	// Filters and dashboards can be shared with everyone, including
+	// anonymous users when they are allowed to browse projects
+	{"jira.option.globalsharing", "true", "anonymous", "global_sharing"},
+	// Email addresses are shown to anyone who can view a user profile
+	{"jira.option.emailvisible", "show", "anonymous", "email_visibility"},
+}
+
+// publicSharingSetting returns the public sharing setting an application
+// property enables, if any
+func publicSharingSetting(property AdvancedApplicationProperty) (PublicSharingSetting, bool) {
+	for _, setting := range publicSharingSettings {
+		if property.Key == setting.Key && strings.EqualFold(property.Value, setting.Value) {
+			return setting, true
+		}
+	}
+	return PublicSharingSetting{}, false
+}
+
+// jiraURL returns the absolute URL of a path in the Jira site
+func jiraURL(client *jira.Client, path string) string {
+	baseURL := client.GetBaseURL()
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(baseURL.String(), "/"), path)
+}
+
+//// Custom Structs
+
+type SearchDashboardResult struct {
+	MaxResults int         `json:"maxResults"`
+	StartAt    int         `json:"startAt"`
+	Total      int         `json:"total"`
+	IsLast     bool        `json:"isLast"`
+	Values     []Dashboard `json:"values"`
+}
+
+type PublicSharingSetting struct {
+	Key    string
+	Value  string
+	Scope  string
+	Access string
+}
+
+type PublicExposure struct {
+	ResourceType   string
+	ResourceId     string
+	ResourceName   string
+	ExposureScope  string
+	Access         string
+	ShareType      string
+	OwnerAccountId string
+	URL            string
+}
@@ -0,0 +1,170 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableResolution(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_resolution",
+		Description: "Resolutions describe the ways in which an issue can be closed, such as Done, Won't Do or Duplicate.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getResolution,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listResolutions,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the issue resolution.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the issue resolution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the issue resolution.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_default",
+				Description: "Whether this is the default resolution.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the issue resolution.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listResolutions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_resolution.listResolutions", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 1000
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 1000 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/resolution/search?startAt=%d&maxResults=%d", last, maxResults)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_resolution.listResolutions", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListResolutionResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_resolution.listResolutions", "api_error", err)
+			return nil, err
+		}
+
+		for _, resolution := range listResult.Values {
+			d.StreamListItem(ctx, resolution)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getResolution(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	resolutionId := d.EqualsQualString("id")
+	if resolutionId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_resolution.getResolution", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/resolution/%s", url.PathEscape(resolutionId))
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_resolution.getResolution", "get_request_error", err)
+		return nil, err
+	}
+
+	resolution := new(Resolution)
+	_, err = client.Do(req, resolution)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_resolution.getResolution", "api_error", err)
+		return nil, err
+	}
+
+	return *resolution, nil
+}
+
+//// Custom Structs
+
+type ListResolutionResult struct {
+	Self       string       `json:"self"`
+	NextPage   string       `json:"nextPage"`
+	MaxResults int          `json:"maxResults"`
+	StartAt    int          `json:"startAt"`
+	Total      int          `json:"total"`
+	IsLast     bool         `json:"isLast"`
+	Values     []Resolution `json:"values"`
+}
+
+type Resolution struct {
+	ID          string `json:"id"`
+	Self        string `json:"self"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsDefault   bool   `json:"isDefault"`
+}
@@ -0,0 +1,276 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableScreenScheme(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_screen_scheme",
+		Description: "Screen schemes define the screens used when an issue is created, viewed and edited.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getScreenScheme,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listScreenSchemes,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the screen scheme.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the screen scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the screen scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_screen_id",
+				Description: "The ID of the screen used for operations without a specific screen.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Screens.Default").NullIfZero(),
+			},
+			{
+				Name:        "create_screen_id",
+				Description: "The ID of the screen used when an issue is created.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Screens.Create").NullIfZero(),
+			},
+			{
+				Name:        "edit_screen_id",
+				Description: "The ID of the screen used when an issue is edited.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Screens.Edit").NullIfZero(),
+			},
+			{
+				Name:        "view_screen_id",
+				Description: "The ID of the screen used when an issue is viewed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Screens.View").NullIfZero(),
+			},
+
+			// JSON fields
+			{
+				Name:        "issue_type_screen_scheme_ids",
+				Description: "The IDs of the issue type screen schemes that use the screen scheme.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getScreenSchemeIssueTypeScreenSchemeIds,
+				Transform:   transform.FromValue(),
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listScreenSchemes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_screen_scheme.listScreenSchemes", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 100
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 100 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/screenscheme?expand=issueTypeScreenSchemes&startAt=%d&maxResults=%d", last, maxResults)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_screen_scheme.listScreenSchemes", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListScreenSchemeResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_screen_scheme.listScreenSchemes", "api_error", err)
+			return nil, err
+		}
+
+		for _, scheme := range listResult.Values {
+			d.StreamListItem(ctx, scheme)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getScreenScheme(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	schemeId := d.EqualsQuals["id"].GetInt64Value()
+	if schemeId == 0 {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_screen_scheme.getScreenScheme", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/screenscheme?expand=issueTypeScreenSchemes&id=%d", schemeId)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_screen_scheme.getScreenScheme", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListScreenSchemeResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_screen_scheme.getScreenScheme", "api_error", err)
+		return nil, err
+	}
+
+	if len(listResult.Values) > 0 {
+		return listResult.Values[0], nil
+	}
+	return nil, nil
+}
+
+// getScreenSchemeIssueTypeScreenSchemeIds returns the expanded issue type
+// screen schemes of the scheme, falling back to the issue type screen scheme
+// mappings when the expansion was truncated.
+func getScreenSchemeIssueTypeScreenSchemeIds(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	scheme := h.Item.(ScreenScheme)
+	if scheme.IssueTypeScreenSchemes.complete() {
+		return scheme.IssueTypeScreenSchemes.ids(), nil
+	}
+
+	schemes, err := getScreenSchemeIssueTypeScreenSchemes(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	return schemes[fmt.Sprint(scheme.ID)], nil
+}
+
+// getScreenSchemeIssueTypeScreenSchemes returns the IDs of the issue type
+// screen schemes that use each screen scheme, keyed by screen scheme ID. The
+// mappings cannot be filtered by screen scheme, so all of them are read and
+// the result is cached as it is shared by all the rows of the table.
+func getScreenSchemeIssueTypeScreenSchemes(ctx context.Context, d *plugin.QueryData) (map[string][]string, error) {
+	cacheKey := "screen_scheme_issue_type_screen_schemes"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(map[string][]string), nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_screen_scheme.getScreenSchemeIssueTypeScreenSchemes", "connection_error", err)
+		return nil, err
+	}
+
+	schemes := map[string][]string{}
+	seen := map[string]bool{}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/issuetypescreenscheme/mapping?startAt=%d&maxResults=100", last)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_screen_scheme.getScreenSchemeIssueTypeScreenSchemes", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListIssueTypeScreenSchemeMappingResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_screen_scheme.getScreenSchemeIssueTypeScreenSchemes", "api_error", err)
+			return nil, err
+		}
+
+		// A screen scheme can be mapped to several issue types of the same
+		// issue type screen scheme
+		for _, mapping := range listResult.Values {
+			key := mapping.ScreenSchemeID + "/" + mapping.IssueTypeScreenSchemeID
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			schemes[mapping.ScreenSchemeID] = append(schemes[mapping.ScreenSchemeID], mapping.IssueTypeScreenSchemeID)
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			break
+		}
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, schemes)
+
+	return schemes, nil
+}
+
+//// Custom Structs
+
+type ListScreenSchemeResult struct {
+	Self       string         `json:"self"`
+	NextPage   string         `json:"nextPage"`
+	MaxResults int            `json:"maxResults"`
+	StartAt    int            `json:"startAt"`
+	Total      int            `json:"total"`
+	IsLast     bool           `json:"isLast"`
+	Values     []ScreenScheme `json:"values"`
+}
+
+type ScreenScheme struct {
+	ID                     int64              `json:"id"`
+	Name                   string             `json:"name"`
+	Description            string             `json:"description"`
+	Screens                ScreenSchemeScreen `json:"screens"`
+	IssueTypeScreenSchemes SchemeItems        `json:"issueTypeScreenSchemes"`
+}
+
+type ScreenSchemeScreen struct {
+	Default int64 `json:"default"`
+	Create  int64 `json:"create"`
+	Edit    int64 `json:"edit"`
+	View    int64 `json:"view"`
+}
@@ -0,0 +1,169 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableScreenTabField(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_screen_tab_field",
+		Description: "The fields placed on each tab of each screen, in the order they are displayed.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listScreens,
+			Hydrate:       listScreenTabFields,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "screen_id", Require: plugin.Optional},
+				{Name: "field_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "screen_id",
+				Description: "The ID of the screen.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "screen_name",
+				Description: "The name of the screen.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tab_id",
+				Description: "The ID of the screen tab.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "tab_name",
+				Description: "The name of the screen tab.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tab_position",
+				Description: "The position of the tab on the screen, starting at 0.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "field_id",
+				Description: "The ID of the field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "field_name",
+				Description: "The name of the field.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "position",
+				Description: "The position of the field on the tab, starting at 0.",
+				Type:        proto.ColumnType_INT,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listScreenTabFields(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	screen := h.Item.(Screen)
+
+	if d.EqualsQuals["screen_id"] != nil && d.EqualsQuals["screen_id"].GetInt64Value() != screen.ID {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_screen_tab_field.listScreenTabFields", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/screens/%d/tabs", screen.ID)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_screen_tab_field.listScreenTabFields", "get_request_error", err)
+		return nil, err
+	}
+
+	tabs := []ScreenTab{}
+	_, err = client.Do(req, &tabs)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_screen_tab_field.listScreenTabFields", "api_error", err)
+		return nil, err
+	}
+
+	fieldId := d.EqualsQualString("field_id")
+	for tabPosition, tab := range tabs {
+		apiEndpoint := fmt.Sprintf("rest/api/3/screens/%d/tabs/%d/fields", screen.ID, tab.ID)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_screen_tab_field.listScreenTabFields", "get_request_error", err)
+			return nil, err
+		}
+
+		fields := []ScreenTabFieldDetail{}
+		_, err = client.Do(req, &fields)
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			plugin.Logger(ctx).Error("jira_screen_tab_field.listScreenTabFields", "api_error", err)
+			return nil, err
+		}
+
+		for position, field := range fields {
+			if fieldId != "" && field.ID != fieldId {
+				continue
+			}
+			d.StreamListItem(ctx, ScreenTabField{
+				ScreenId:    screen.ID,
+				ScreenName:  screen.Name,
+				TabId:       tab.ID,
+				TabName:     tab.Name,
+				TabPosition: tabPosition,
+				FieldId:     field.ID,
+				FieldName:   field.Name,
+				Position:    position,
+			})
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type ScreenTab struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type ScreenTabFieldDetail struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ScreenTabField struct {
+	ScreenId    int64
+	ScreenName  string
+	TabId       int64
+	TabName     string
+	TabPosition int
+	FieldId     string
+	FieldName   string
+	Position    int
+}
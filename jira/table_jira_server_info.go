@@ -0,0 +1,155 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableServerInfo(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_server_info",
+		Description: "Information about the Jira instance, such as its version and deployment type.",
+		List: &plugin.ListConfig{
+			Hydrate: listServerInfo,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "base_url",
+				Description: "The base URL of the Jira instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BaseURL"),
+			},
+			{
+				Name:        "server_title",
+				Description: "The name of the Jira instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The version of Jira.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "deployment_type",
+				Description: "The type of deployment. Possible values are Cloud, Server and DataCenter.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "build_number",
+				Description: "The build number of the Jira version.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "build_date",
+				Description: "The timestamp when the Jira version was built.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("BuildDate").Transform(convertJiraTime),
+			},
+			{
+				Name:        "server_time",
+				Description: "The time in Jira when this request was responded to.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ServerTime").Transform(convertJiraTime),
+			},
+			{
+				Name:        "scm_info",
+				Description: "The unique identifier of the Jira version in source control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_locale",
+				Description: "The default locale of the Jira instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DefaultLocale.Locale"),
+			},
+
+			// JSON fields
+			{
+				Name:        "version_numbers",
+				Description: "The major, minor and revision version numbers of the Jira version.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "health_checks",
+				Description: "The results of the health checks of the Jira instance, if any.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerTitle"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listServerInfo(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	serverInfo, err := getServerInfo(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, serverInfo)
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getServerInfo returns the server information of the Jira instance. It is
+// not cached, as it includes the current server time and health checks.
+func getServerInfo(ctx context.Context, d *plugin.QueryData) (*ServerInfo, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_server_info.getServerInfo", "connection_error", err)
+		return nil, err
+	}
+
+	// The version 2 API is used as it is available in every deployment type
+	req, err := client.NewRequestWithContext(ctx, "GET", "rest/api/2/serverInfo", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_server_info.getServerInfo", "get_request_error", err)
+		return nil, err
+	}
+
+	serverInfo := new(ServerInfo)
+	_, err = client.Do(req, serverInfo)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_server_info.getServerInfo", "api_error", err)
+		return nil, err
+	}
+
+	return serverInfo, nil
+}
+
+//// Custom Structs
+
+type ServerInfo struct {
+	BaseURL        string `json:"baseUrl"`
+	ServerTitle    string `json:"serverTitle"`
+	Version        string `json:"version"`
+	VersionNumbers []int  `json:"versionNumbers"`
+	DeploymentType string `json:"deploymentType"`
+	BuildNumber    int64  `json:"buildNumber"`
+	BuildDate      string `json:"buildDate"`
+	ServerTime     string `json:"serverTime"`
+	ScmInfo        string `json:"scmInfo"`
+	DefaultLocale  struct {
+		Locale string `json:"locale"`
+	} `json:"defaultLocale"`
+	HealthChecks []struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Passed      bool   `json:"passed"`
+	} `json:"healthChecks"`
+}
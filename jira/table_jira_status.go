@@ -0,0 +1,289 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableStatus(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_status",
+		Description: "Statuses represent the state of an issue at a specific point in its workflow.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getStatus,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listStatuses,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Optional},
+				{Name: "scope_project_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_category",
+				Description: "The category of the status. Possible values are TODO, IN_PROGRESS and DONE.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_category_key",
+				Description: "The key of the status category, which joins to the jira_status_category table. Possible values are new, indeterminate and done.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StatusCategory").Transform(statusCategoryToKey),
+			},
+			{
+				Name:        "scope_type",
+				Description: "The scope of the status. GLOBAL for company-managed statuses, PROJECT for team-managed statuses.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Scope.Type"),
+			},
+			{
+				Name:        "scope_project_id",
+				Description: "The ID of the project the status is scoped to, for team-managed statuses.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Scope.Project.ID").NullIfZero(),
+			},
+
+			// JSON fields
+			{
+				Name:        "usages",
+				Description: "The projects and issue types where the status is used.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listStatuses(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status.listStatuses", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 200
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 200 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	query := ""
+	if d.EqualsQualString("name") != "" {
+		query = fmt.Sprintf("%s&searchString=%s", query, url.QueryEscape(d.EqualsQualString("name")))
+	}
+	if d.EqualsQualString("scope_project_id") != "" {
+		query = fmt.Sprintf("%s&projectId=%s", query, url.QueryEscape(d.EqualsQualString("scope_project_id")))
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf(
+			"rest/api/3/statuses/search?expand=usages&startAt=%d&maxResults=%d%s",
+			last,
+			maxResults,
+			query,
+		)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_status.listStatuses", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListStatusResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_status.listStatuses", "api_error", err)
+			return nil, err
+		}
+
+		for _, status := range listResult.Values {
+			d.StreamListItem(ctx, status)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStatus(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	statusId := d.EqualsQualString("id")
+	if statusId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status.getStatus", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/statuses?expand=usages&id=%s", url.QueryEscape(statusId))
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status.getStatus", "get_request_error", err)
+		return nil, err
+	}
+
+	statuses := []Status{}
+	_, err = client.Do(req, &statuses)
+	if err != nil {
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_status.getStatus", "api_error", err)
+		return nil, err
+	}
+
+	if len(statuses) > 0 {
+		return statuses[0], nil
+	}
+	return nil, nil
+}
+
+// getStatusCategories returns the status category of every status, keyed
+// by status ID. The result is cached as it is shared by the workflow tables.
+func getStatusCategories(ctx context.Context, d *plugin.QueryData) (map[string]string, error) {
+	cacheKey := "status_categories"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(map[string]string), nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status.getStatusCategories", "connection_error", err)
+		return nil, err
+	}
+
+	categories := map[string]string{}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/statuses/search?startAt=%d&maxResults=200", last)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_status.getStatusCategories", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListStatusResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_status.getStatusCategories", "api_error", err)
+			return nil, err
+		}
+
+		for _, status := range listResult.Values {
+			categories[status.ID] = status.StatusCategory
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			break
+		}
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, categories)
+
+	return categories, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func statusCategoryToKey(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if key := statusCategoryKey(d.Value.(string)); key != "" {
+		return key, nil
+	}
+	return nil, nil
+}
+
+// statusCategoryKey maps the status category of the statuses API to the
+// stable status category key used elsewhere in Jira
+func statusCategoryKey(category string) string {
+	switch category {
+	case "TODO":
+		return "new"
+	case "IN_PROGRESS":
+		return "indeterminate"
+	case "DONE":
+		return "done"
+	}
+	return ""
+}
+
+//// Custom Structs
+
+type ListStatusResult struct {
+	Self       string   `json:"self"`
+	NextPage   string   `json:"nextPage"`
+	MaxResults int      `json:"maxResults"`
+	StartAt    int      `json:"startAt"`
+	Total      int      `json:"total"`
+	IsLast     bool     `json:"isLast"`
+	Values     []Status `json:"values"`
+}
+
+type Status struct {
+	ID             string        `json:"id"`
+	Name           string        `json:"name"`
+	Description    string        `json:"description"`
+	StatusCategory string        `json:"statusCategory"`
+	Scope          StatusScope   `json:"scope"`
+	Usages         []interface{} `json:"usages"`
+}
+
+type StatusScope struct {
+	Type    string `json:"type"`
+	Project struct {
+		ID string `json:"id"`
+	} `json:"project"`
+}
@@ -0,0 +1,131 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableStatusCategory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_status_category",
+		Description: "Status categories group statuses into To Do, In Progress and Done.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getStatusCategory,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listStatusCategories,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the status category.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "key",
+				Description: "The key of the status category. Possible values are undefined, new, indeterminate and done.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the status category.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "color_name",
+				Description: "The name of the color used to represent the status category.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the status category.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listStatusCategories(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status_category.listStatusCategories", "connection_error", err)
+		return nil, err
+	}
+
+	req, err := client.NewRequest("GET", "rest/api/3/statuscategory", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status_category.listStatusCategories", "get_request_error", err)
+		return nil, err
+	}
+
+	categories := []V3StatusCategory{}
+	_, err = client.Do(req, &categories)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status_category.listStatusCategories", "api_error", err)
+		return nil, err
+	}
+
+	for _, category := range categories {
+		d.StreamListItem(ctx, category)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getStatusCategory(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	categoryId := d.EqualsQuals["id"].GetInt64Value()
+	if categoryId == 0 {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status_category.getStatusCategory", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/statuscategory/%d", categoryId)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_status_category.getStatusCategory", "get_request_error", err)
+		return nil, err
+	}
+
+	category := new(V3StatusCategory)
+	_, err = client.Do(req, category)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_status_category.getStatusCategory", "api_error", err)
+		return nil, err
+	}
+
+	return *category, nil
+}
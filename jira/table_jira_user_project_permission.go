@@ -0,0 +1,383 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableUserProjectPermission(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_user_project_permission",
+		Description: "Effective project permissions of a user, with one row per project, permission and the grant it comes from.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listUserProjectPermissions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "account_id", Require: plugin.Required},
+				{Name: "project_key", Require: plugin.Optional},
+				{Name: "permission", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "account_id",
+				Description: "The account ID of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_key",
+				Description: "The key of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "permission",
+				Description: "The key of the permission, for example BROWSE_PROJECTS, EDIT_ISSUES or DELETE_ISSUES.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "conditional",
+				Description: "True if the permission only applies to some issues, for example issues the user reported or is assigned to.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "holder_type",
+				Description: "The type of the permission holder the permission is granted through, for example user, group, projectRole or anyone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "holder_display",
+				Description: "A human readable description of the permission holder the permission is granted through.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "grant_path",
+				Description: "An explanation of how the user is granted the permission.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "grant_id",
+				Description: "The ID of the permission grant.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "scheme_id",
+				Description: "The ID of the permission scheme the grant belongs to.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "scheme_name",
+				Description: "The name of the permission scheme the grant belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listUserProjectPermissions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(Project)
+
+	projectKey := d.EqualsQualString("project_key")
+	if projectKey != "" && projectKey != project.Key {
+		return nil, nil
+	}
+
+	accountId := d.EqualsQualString("account_id")
+	if accountId == "" {
+		return nil, nil
+	}
+
+	access, err := getUserAccess(ctx, d, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Inactive users cannot log in and hold no effective permissions
+	if access == nil || !access.Active {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_user_project_permission.listUserProjectPermissions", "connection_error", err)
+		return nil, err
+	}
+
+	projectScheme, err := getPermissionSchemeForProject(ctx, client, project.ID)
+	if err != nil || projectScheme == nil {
+		return nil, err
+	}
+
+	// The scheme of the project does not include the holder details, which
+	// are read from the expanded list of permission schemes
+	schemes, err := getPermissionSchemes(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	var scheme PermissionScheme
+	for _, s := range schemes {
+		if s.ID == projectScheme.ID {
+			scheme = s
+			break
+		}
+	}
+
+	permission := d.EqualsQualString("permission")
+
+	// Load the actors of the project roles used by the grants
+	roleActors := map[int64][]RoleActor{}
+	for _, grant := range scheme.Permissions {
+		if permission != "" && grant.Permission != permission {
+			continue
+		}
+		if grant.Holder.Type != "projectRole" || grant.Holder.ProjectRole == nil {
+			continue
+		}
+		roleId := grant.Holder.ProjectRole.ID
+		if _, ok := roleActors[roleId]; ok {
+			continue
+		}
+		role, err := getProjectRoleActors(ctx, d, project.ID, roleId)
+		if err != nil {
+			return nil, err
+		}
+		roleActors[roleId] = nil
+		if role != nil {
+			roleActors[roleId] = role.Actors
+		}
+	}
+
+	for _, row := range resolveUserProjectPermissions(project, scheme, *access, roleActors, permission) {
+		d.StreamListItem(ctx, row)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getUserAccess returns the groups and application roles of a user, which
+// are needed to resolve the permission grants that apply to them
+func getUserAccess(ctx context.Context, d *plugin.QueryData, accountId string) (*UserAccess, error) {
+	cacheKey := fmt.Sprintf("user_access_%s", accountId)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*UserAccess), nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_user_project_permission.getUserAccess", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/user?accountId=%s&expand=applicationRoles", url.QueryEscape(accountId))
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_user_project_permission.getUserAccess", "get_request_error", err)
+		return nil, err
+	}
+
+	access := new(UserAccess)
+	_, err = client.Do(req, access)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_user_project_permission.getUserAccess", "api_error", err)
+		return nil, err
+	}
+
+	// The groups expanded on the user are limited in size, so they are
+	// read from the dedicated endpoint instead
+	apiEndpoint = fmt.Sprintf("rest/api/3/user/groups?accountId=%s", url.QueryEscape(accountId))
+
+	req, err = client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_user_project_permission.getUserAccess", "get_request_error", err)
+		return nil, err
+	}
+
+	_, err = client.Do(req, &access.Groups)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_user_project_permission.getUserAccess", "api_error", err)
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, access)
+
+	return access, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// resolveUserProjectPermissions returns a row for each way in which the
+// grants of a permission scheme apply to a user in a project, optionally
+// limited to a single permission
+func resolveUserProjectPermissions(project Project, scheme PermissionScheme, access UserAccess, roleActors map[int64][]RoleActor, permission string) []UserProjectPermission {
+	rows := []UserProjectPermission{}
+	for _, grant := range scheme.Permissions {
+		if permission != "" && grant.Permission != permission {
+			continue
+		}
+
+		for _, path := range resolvePermissionGrant(grant, project, access, roleActors) {
+			rows = append(rows, UserProjectPermission{
+				AccountId:     access.AccountID,
+				ProjectId:     project.ID,
+				ProjectKey:    project.Key,
+				Permission:    grant.Permission,
+				Conditional:   path.Conditional,
+				HolderType:    grant.Holder.Type,
+				HolderDisplay: permissionHolderDisplay(grant.Holder),
+				GrantPath:     path.Description,
+				GrantId:       grant.ID,
+				SchemeId:      scheme.ID,
+				SchemeName:    scheme.Name,
+			})
+		}
+	}
+	return rows
+}
+
+// resolvePermissionGrant returns the ways in which a permission grant applies
+// to a user in a project. A grant can apply more than once, for example when
+// the user acts in a project role both directly and through a group.
+func resolvePermissionGrant(grant PermissionGrant, project Project, access UserAccess, roleActors map[int64][]RoleActor) []PermissionGrantPath {
+	holder := grant.Holder
+	display := permissionHolderDisplay(holder)
+
+	switch holder.Type {
+	case "anyone":
+		return []PermissionGrantPath{{Description: "granted to anyone, including anonymous users"}}
+	case "user":
+		if holder.Parameter == access.AccountID || (holder.User != nil && holder.User.AccountID == access.AccountID) {
+			return []PermissionGrantPath{{Description: "granted directly to the user"}}
+		}
+	case "group":
+		if group, ok := access.memberOf(holder.Parameter, holder.Value, holder.Group); ok {
+			return []PermissionGrantPath{{Description: fmt.Sprintf("member of group '%s'", group.Name)}}
+		}
+	case "applicationRole":
+		// Any logged in user only covers users with access to an application
+		if holder.Parameter == "" {
+			if len(access.ApplicationRoles.Items) > 0 {
+				return []PermissionGrantPath{{Description: "granted to any logged in user"}}
+			}
+			return nil
+		}
+		for _, role := range access.ApplicationRoles.Items {
+			if role.Key == holder.Parameter {
+				return []PermissionGrantPath{{Description: fmt.Sprintf("has access to application '%s'", role.Name)}}
+			}
+		}
+	case "projectLead":
+		if project.Lead.AccountID == access.AccountID {
+			return []PermissionGrantPath{{Description: fmt.Sprintf("lead of project %s", project.Key)}}
+		}
+	case "projectRole":
+		if holder.ProjectRole == nil {
+			return nil
+		}
+		paths := []PermissionGrantPath{}
+		for _, actor := range roleActors[holder.ProjectRole.ID] {
+			switch actor.Type {
+			case "atlassian-user-role-actor":
+				if actor.ActorUser.AccountID == access.AccountID {
+					paths = append(paths, PermissionGrantPath{
+						Description: fmt.Sprintf("actor in project role '%s'", display),
+					})
+				}
+			case "atlassian-group-role-actor":
+				if group, ok := access.memberOf(actor.ActorGroup.Name, actor.ActorGroup.GroupId, nil); ok {
+					paths = append(paths, PermissionGrantPath{
+						Description: fmt.Sprintf("member of group '%s', which is an actor in project role '%s'", group.Name, display),
+					})
+				}
+			}
+		}
+		return paths
+	case "reporter":
+		return []PermissionGrantPath{{Conditional: true, Description: "only on issues the user reported"}}
+	case "assignee":
+		return []PermissionGrantPath{{Conditional: true, Description: "only on issues assigned to the user"}}
+	case "userCustomField":
+		return []PermissionGrantPath{{Conditional: true, Description: fmt.Sprintf("only on issues where the user is selected in field '%s'", display)}}
+	case "groupCustomField":
+		return []PermissionGrantPath{{Conditional: true, Description: fmt.Sprintf("only on issues where field '%s' contains a group the user is a member of", display)}}
+	case "sd.customer.portal.only":
+		return []PermissionGrantPath{{Conditional: true, Description: "only as a customer of the service project through the portal"}}
+	}
+	return nil
+}
+
+// memberOf returns the group of the user that matches a group name or ID
+func (access UserAccess) memberOf(name string, id string, group *Group) (Group, bool) {
+	if group != nil {
+		if name == "" {
+			name = group.Name
+		}
+		if id == "" {
+			id = group.GroupId
+		}
+	}
+	for _, g := range access.Groups {
+		if (name != "" && g.Name == name) || (id != "" && g.GroupId == id) {
+			return g, true
+		}
+	}
+	return Group{}, false
+}
+
+//// Custom Structs
+
+type UserAccess struct {
+	AccountID        string  `json:"accountId"`
+	DisplayName      string  `json:"displayName"`
+	Active           bool    `json:"active"`
+	Groups           []Group `json:"-"`
+	ApplicationRoles struct {
+		Items []UserApplicationRole `json:"items"`
+	} `json:"applicationRoles"`
+}
+
+type UserApplicationRole struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+type PermissionGrantPath struct {
+	Conditional bool
+	Description string
+}
+
+type UserProjectPermission struct {
+	AccountId     string
+	ProjectId     string
+	ProjectKey    string
+	Permission    string
+	Conditional   bool
+	HolderType    string
+	HolderDisplay string
+	GrantPath     string
+	GrantId       int64
+	SchemeId      int64
+	SchemeName    string
+}
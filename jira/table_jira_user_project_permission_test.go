@@ -0,0 +1,384 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/andygrunwald/go-jira"
+)
+
+func userRoleActor(accountId string) RoleActor {
+	actor := RoleActor{Type: "atlassian-user-role-actor"}
+	actor.ActorUser.AccountID = accountId
+	return actor
+}
+
+func groupRoleActor(name string, groupId string) RoleActor {
+	actor := RoleActor{Type: "atlassian-group-role-actor"}
+	actor.ActorGroup.Name = name
+	actor.ActorGroup.GroupId = groupId
+	return actor
+}
+
+func TestResolvePermissionGrant(t *testing.T) {
+	project := Project{ID: "10000", Key: "TEST", Lead: jira.User{AccountID: "lead"}}
+
+	access := UserAccess{
+		AccountID: "user",
+		Active:    true,
+		Groups:    []Group{{Name: "jira-developers", GroupId: "group-developers"}},
+	}
+	access.ApplicationRoles.Items = []UserApplicationRole{{Key: "jira-software", Name: "Jira Software"}}
+
+	noApplicationAccess := access
+	noApplicationAccess.ApplicationRoles.Items = nil
+
+	lead := access
+	lead.AccountID = "lead"
+
+	roleActors := map[int64][]RoleActor{
+		10002: {userRoleActor("user")},
+		10003: {groupRoleActor("jira-developers", "group-developers")},
+		10004: {userRoleActor("someone-else"), groupRoleActor("jira-administrators", "group-administrators")},
+		10005: {userRoleActor("user"), groupRoleActor("", "group-developers")},
+	}
+
+	role := func(id int64, name string) *PermissionHolderRole {
+		return &PermissionHolderRole{ID: id, Name: name}
+	}
+
+	cases := []struct {
+		name   string
+		holder PermissionHolder
+		access UserAccess
+		want   []PermissionGrantPath
+	}{
+		{
+			name:   "anyone",
+			holder: PermissionHolder{Type: "anyone"},
+			access: access,
+			want:   []PermissionGrantPath{{Description: "granted to anyone, including anonymous users"}},
+		},
+		{
+			name:   "user by parameter",
+			holder: PermissionHolder{Type: "user", Parameter: "user"},
+			access: access,
+			want:   []PermissionGrantPath{{Description: "granted directly to the user"}},
+		},
+		{
+			name:   "user by expanded user",
+			holder: PermissionHolder{Type: "user", User: &V3User{AccountID: "user"}},
+			access: access,
+			want:   []PermissionGrantPath{{Description: "granted directly to the user"}},
+		},
+		{
+			name:   "other user",
+			holder: PermissionHolder{Type: "user", Parameter: "someone-else"},
+			access: access,
+		},
+		{
+			name:   "group by name",
+			holder: PermissionHolder{Type: "group", Parameter: "jira-developers"},
+			access: access,
+			want:   []PermissionGrantPath{{Description: "member of group 'jira-developers'"}},
+		},
+		{
+			name:   "group by group id",
+			holder: PermissionHolder{Type: "group", Value: "group-developers"},
+			access: access,
+			want:   []PermissionGrantPath{{Description: "member of group 'jira-developers'"}},
+		},
+		{
+			name:   "group by expanded group",
+			holder: PermissionHolder{Type: "group", Group: &Group{GroupId: "group-developers"}},
+			access: access,
+			want:   []PermissionGrantPath{{Description: "member of group 'jira-developers'"}},
+		},
+		{
+			name:   "group the user is not a member of",
+			holder: PermissionHolder{Type: "group", Parameter: "jira-administrators", Value: "group-administrators"},
+			access: access,
+		},
+		{
+			name:   "project role through a user actor",
+			holder: PermissionHolder{Type: "projectRole", ProjectRole: role(10002, "Developers")},
+			access: access,
+			want:   []PermissionGrantPath{{Description: "actor in project role 'Developers'"}},
+		},
+		{
+			name:   "project role through a group actor",
+			holder: PermissionHolder{Type: "projectRole", ProjectRole: role(10003, "Developers")},
+			access: access,
+			want:   []PermissionGrantPath{{Description: "member of group 'jira-developers', which is an actor in project role 'Developers'"}},
+		},
+		{
+			name:   "project role through both a user and a group actor",
+			holder: PermissionHolder{Type: "projectRole", ProjectRole: role(10005, "Developers")},
+			access: access,
+			want: []PermissionGrantPath{
+				{Description: "actor in project role 'Developers'"},
+				{Description: "member of group 'jira-developers', which is an actor in project role 'Developers'"},
+			},
+		},
+		{
+			name:   "project role the user does not act in",
+			holder: PermissionHolder{Type: "projectRole", ProjectRole: role(10004, "Administrators")},
+			access: access,
+		},
+		{
+			name:   "project role without actors",
+			holder: PermissionHolder{Type: "projectRole", ProjectRole: role(10099, "Users")},
+			access: access,
+		},
+		{
+			name:   "project lead",
+			holder: PermissionHolder{Type: "projectLead"},
+			access: lead,
+			want:   []PermissionGrantPath{{Description: "lead of project TEST"}},
+		},
+		{
+			name:   "not the project lead",
+			holder: PermissionHolder{Type: "projectLead"},
+			access: access,
+		},
+		{
+			name:   "any logged in user",
+			holder: PermissionHolder{Type: "applicationRole"},
+			access: access,
+			want:   []PermissionGrantPath{{Description: "granted to any logged in user"}},
+		},
+		{
+			name:   "any logged in user without application access",
+			holder: PermissionHolder{Type: "applicationRole"},
+			access: noApplicationAccess,
+		},
+		{
+			name:   "application role",
+			holder: PermissionHolder{Type: "applicationRole", Parameter: "jira-software"},
+			access: access,
+			want:   []PermissionGrantPath{{Description: "has access to application 'Jira Software'"}},
+		},
+		{
+			name:   "application role the user does not have",
+			holder: PermissionHolder{Type: "applicationRole", Parameter: "jira-servicedesk"},
+			access: access,
+		},
+		{
+			name:   "reporter",
+			holder: PermissionHolder{Type: "reporter"},
+			access: access,
+			want:   []PermissionGrantPath{{Conditional: true, Description: "only on issues the user reported"}},
+		},
+		{
+			name:   "assignee",
+			holder: PermissionHolder{Type: "assignee"},
+			access: access,
+			want:   []PermissionGrantPath{{Conditional: true, Description: "only on issues assigned to the user"}},
+		},
+		{
+			name:   "user custom field",
+			holder: PermissionHolder{Type: "userCustomField", Parameter: "customfield_10050", Field: map[string]interface{}{"name": "Approvers"}},
+			access: access,
+			want:   []PermissionGrantPath{{Conditional: true, Description: "only on issues where the user is selected in field 'Approvers'"}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			grant := PermissionGrant{ID: 1, Holder: c.holder, Permission: "BROWSE_PROJECTS"}
+			got := resolvePermissionGrant(grant, project, c.access, roleActors)
+			if len(got) == 0 && len(c.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("resolvePermissionGrant() = %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestUserAccessMemberOf(t *testing.T) {
+	access := UserAccess{
+		Groups: []Group{
+			{Name: "jira-developers", GroupId: "group-developers"},
+			{Name: "jira-testers", GroupId: "group-testers"},
+		},
+	}
+
+	cases := []struct {
+		name    string
+		group   string
+		groupId string
+		holder  *Group
+		want    string
+		wantOk  bool
+	}{
+		{name: "by name", group: "jira-testers", want: "jira-testers", wantOk: true},
+		{name: "by group id", groupId: "group-developers", want: "jira-developers", wantOk: true},
+		{name: "by expanded group name", holder: &Group{Name: "jira-testers"}, want: "jira-testers", wantOk: true},
+		{name: "by expanded group id", holder: &Group{GroupId: "group-testers"}, want: "jira-testers", wantOk: true},
+		{name: "not a member", group: "jira-administrators", groupId: "group-administrators"},
+		{name: "empty", wantOk: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := access.memberOf(c.group, c.groupId, c.holder)
+			if ok != c.wantOk || got.Name != c.want {
+				t.Errorf("memberOf() = %q, %v, want %q, %v", got.Name, ok, c.want, c.wantOk)
+			}
+		})
+	}
+}
+
+// The cross-check below compares the resolved permissions with the ones Jira
+// reports for the calling user. It runs against responses recorded in
+// testdata, and against a live site when JIRA_URL, JIRA_USER, JIRA_TOKEN and
+// JIRA_TEST_PROJECT_KEY are set.
+
+type myPermissionsResult struct {
+	Permissions map[string]struct {
+		HavePermission bool `json:"havePermission"`
+	} `json:"permissions"`
+}
+
+func TestUserProjectPermissionsMatchMyPermissions(t *testing.T) {
+	files := map[string]string{
+		"/rest/api/3/project/DEV":                    "project.json",
+		"/rest/api/3/project/10000/permissionscheme": "permission_scheme.json",
+		"/rest/api/3/project/10000/role/10002":       "role_10002.json",
+		"/rest/api/3/project/10000/role/10003":       "role_10003.json",
+		"/rest/api/3/user":                           "user.json",
+		"/rest/api/3/user/groups":                    "groups.json",
+		"/rest/api/3/mypermissions":                  "mypermissions.json",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, ok := files[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", "user_project_permission", file))
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client, err := jira.NewClient(server.Client(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkUserProjectPermissions(t, client, "DEV", "5b10a2844c20165700ede21g")
+}
+
+func TestUserProjectPermissionsMatchMyPermissionsLive(t *testing.T) {
+	baseUrl := os.Getenv("JIRA_URL")
+	username := os.Getenv("JIRA_USER")
+	token := os.Getenv("JIRA_TOKEN")
+	projectKey := os.Getenv("JIRA_TEST_PROJECT_KEY")
+	if baseUrl == "" || username == "" || token == "" || projectKey == "" {
+		t.Skip("JIRA_URL, JIRA_USER, JIRA_TOKEN and JIRA_TEST_PROJECT_KEY must be set to run against a live site")
+	}
+
+	tokenProvider := jira.BasicAuthTransport{Username: username, Password: token}
+	client, err := jira.NewClient(tokenProvider.Client(), baseUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	myself := new(V3User)
+	getTestJSON(t, client, "rest/api/3/myself", myself)
+
+	checkUserProjectPermissions(t, client, projectKey, myself.AccountID)
+}
+
+// checkUserProjectPermissions resolves the permissions of the calling user in
+// a project the same way listUserProjectPermissions does, and compares them
+// with the result of mypermissions. Permissions that are only granted on some
+// issues, such as to the reporter or assignee, are left out as Jira evaluates
+// them against each issue.
+func checkUserProjectPermissions(t *testing.T, client *jira.Client, projectKey string, accountId string) {
+	t.Helper()
+
+	project := Project{}
+	getTestJSON(t, client, "rest/api/3/project/"+url.PathEscape(projectKey), &project)
+
+	scheme := PermissionScheme{}
+	getTestJSON(t, client, fmt.Sprintf("rest/api/3/project/%s/permissionscheme?expand=all", project.ID), &scheme)
+
+	access := UserAccess{}
+	getTestJSON(t, client, "rest/api/3/user?expand=applicationRoles&accountId="+url.QueryEscape(accountId), &access)
+	getTestJSON(t, client, "rest/api/3/user/groups?accountId="+url.QueryEscape(accountId), &access.Groups)
+
+	roleActors := map[int64][]RoleActor{}
+	permissions := []string{}
+	for _, grant := range scheme.Permissions {
+		permissions = append(permissions, grant.Permission)
+		if grant.Holder.Type != "projectRole" || grant.Holder.ProjectRole == nil {
+			continue
+		}
+		roleId := grant.Holder.ProjectRole.ID
+		if _, ok := roleActors[roleId]; ok {
+			continue
+		}
+		role := ProjectRoleActors{}
+		getTestJSON(t, client, fmt.Sprintf("rest/api/3/project/%s/role/%d", project.ID, roleId), &role)
+		roleActors[roleId] = role.Actors
+	}
+
+	myPermissions := myPermissionsResult{}
+	getTestJSON(t, client, fmt.Sprintf(
+		"rest/api/3/mypermissions?projectKey=%s&permissions=%s",
+		url.QueryEscape(project.Key),
+		url.QueryEscape(strings.Join(permissions, ",")),
+	), &myPermissions)
+
+	granted := map[string]bool{}
+	conditional := map[string]bool{}
+	for _, row := range resolveUserProjectPermissions(project, scheme, access, roleActors, "") {
+		if row.Conditional {
+			conditional[row.Permission] = true
+		} else {
+			granted[row.Permission] = true
+		}
+	}
+
+	for _, permission := range permissions {
+		if conditional[permission] && !granted[permission] {
+			continue
+		}
+		mine, ok := myPermissions.Permissions[permission]
+		if !ok {
+			t.Errorf("mypermissions did not return %s", permission)
+			continue
+		}
+		if granted[permission] != mine.HavePermission {
+			t.Errorf("%s: resolved %v, mypermissions reports %v", permission, granted[permission], mine.HavePermission)
+		}
+	}
+}
+
+func getTestJSON(t *testing.T, client *jira.Client, apiEndpoint string, v interface{}) {
+	t.Helper()
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req, v); err != nil {
+		t.Fatalf("GET %s: %v", apiEndpoint, err)
+	}
+}
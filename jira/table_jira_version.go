@@ -0,0 +1,272 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_version",
+		Description: "Versions are points in time for a project, such as releases, used to schedule and organize work.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getVersion,
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listVersions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "released", Require: plugin.Optional, Operators: []string{"="}},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The unique name of the version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project to which this version is attached.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID").Transform(transform.ToString),
+			},
+			{
+				Name:        "released",
+				Description: "Indicates that the version is released.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "archived",
+				Description: "Indicates that the version is archived.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "overdue",
+				Description: "Indicates that the version is overdue, i.e. unreleased with a release date in the past.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "start_date",
+				Description: "The start date of the version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StartDate").NullIfZero().Transform(convertJiraDate),
+			},
+			{
+				Name:        "release_date",
+				Description: "The release date of the version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ReleaseDate").NullIfZero().Transform(convertJiraDate),
+			},
+			{
+				Name:        "issues_to_do_count",
+				Description: "The number of issues with this fix version in the To Do status category.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IssuesStatusForFixVersion.ToDo"),
+			},
+			{
+				Name:        "issues_in_progress_count",
+				Description: "The number of issues with this fix version in the In Progress status category.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IssuesStatusForFixVersion.InProgress"),
+			},
+			{
+				Name:        "issues_done_count",
+				Description: "The number of issues with this fix version in the Done status category.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IssuesStatusForFixVersion.Done"),
+			},
+			{
+				Name:        "issues_unmapped_count",
+				Description: "The number of issues with this fix version whose status is not mapped to a status category.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IssuesStatusForFixVersion.Unmapped"),
+			},
+
+			// JSON fields
+			{
+				Name:        "operations",
+				Description: "The operations that the current user can perform on the version.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(Project)
+
+	projectId := d.EqualsQualString("project_id")
+	if projectId != "" && projectId != project.ID {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_version.listVersions", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 1000
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 1000 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	query := ""
+	if d.EqualsQuals["released"] != nil {
+		if d.EqualsQuals["released"].GetBoolValue() {
+			query = "&status=released"
+		} else {
+			query = "&status=unreleased"
+		}
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf(
+			"rest/api/3/project/%s/version?expand=issuesstatus,operations&startAt=%d&maxResults=%d%s",
+			project.ID,
+			last,
+			maxResults,
+			query,
+		)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_version.listVersions", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListVersionResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil, nil
+			}
+			plugin.Logger(ctx).Error("jira_version.listVersions", "api_error", err)
+			return nil, err
+		}
+
+		for _, version := range listResult.Values {
+			d.StreamListItem(ctx, version)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVersion(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	versionId := d.EqualsQualString("id")
+	if versionId == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_version.getVersion", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/version/%s?expand=issuesstatus,operations", versionId)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_version.getVersion", "get_request_error", err)
+		return nil, err
+	}
+
+	version := new(Version)
+	_, err = client.Do(req, version)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_version.getVersion", "api_error", err)
+		return nil, err
+	}
+
+	return *version, nil
+}
+
+//// Custom Structs
+
+type ListVersionResult struct {
+	Self       string    `json:"self"`
+	NextPage   string    `json:"nextPage"`
+	MaxResults int       `json:"maxResults"`
+	StartAt    int       `json:"startAt"`
+	Total      int       `json:"total"`
+	IsLast     bool      `json:"isLast"`
+	Values     []Version `json:"values"`
+}
+
+type Version struct {
+	ID                        string                   `json:"id"`
+	Self                      string                   `json:"self"`
+	Name                      string                   `json:"name"`
+	Description               string                   `json:"description"`
+	ProjectID                 int64                    `json:"projectId"`
+	Released                  bool                     `json:"released"`
+	Archived                  bool                     `json:"archived"`
+	Overdue                   bool                     `json:"overdue"`
+	StartDate                 string                   `json:"startDate"`
+	ReleaseDate               string                   `json:"releaseDate"`
+	IssuesStatusForFixVersion VersionIssuesStatus      `json:"issuesStatusForFixVersion"`
+	Operations                []map[string]interface{} `json:"operations"`
+}
+
+type VersionIssuesStatus struct {
+	Unmapped   int64 `json:"unmapped"`
+	ToDo       int64 `json:"toDo"`
+	InProgress int64 `json:"inProgress"`
+	Done       int64 `json:"done"`
+}
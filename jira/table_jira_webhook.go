@@ -0,0 +1,300 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableWebhook(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_webhook",
+		Description: "Webhooks registered by administrators and by apps, which send Jira events to external URLs.",
+		List: &plugin.ListConfig{
+			Hydrate: listWebhooks,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "source", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "source",
+				Description: "How the webhook was registered. Possible values are admin, for webhooks registered by administrators, and app, for webhooks registered by the calling Connect or OAuth 2.0 app.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the webhook.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the webhook.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").NullIfZero(),
+			},
+			{
+				Name:        "url",
+				Description: "The URL events are sent to. Not returned for app webhooks, which send events to the app.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("URL").NullIfZero(),
+			},
+			{
+				Name:        "enabled",
+				Description: "Whether the webhook is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "jql_filter",
+				Description: "The JQL filter that limits the issues the webhook sends events for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JqlFilter").NullIfZero(),
+			},
+			{
+				Name:        "exclude_body",
+				Description: "Whether the events are sent without a request body.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "last_updated",
+				Description: "The date and time the webhook was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastUpdated").NullIfZero(),
+			},
+			{
+				Name:        "last_updated_by",
+				Description: "The user who last updated the webhook.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LastUpdatedBy").NullIfZero(),
+			},
+			{
+				Name:        "expiration_date",
+				Description: "The date and time the webhook expires, for app webhooks.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ExpirationDate").NullIfZero(),
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the webhook.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Self").NullIfZero(),
+			},
+
+			// JSON fields
+			{
+				Name:        "events",
+				Description: "The events that trigger the webhook.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(webhookTitle),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listWebhooks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	source := d.EqualsQualString("source")
+
+	scanners := []struct {
+		source string
+		scan   func(context.Context, *plugin.QueryData) ([]Webhook, error)
+	}{
+		{"admin", getAdminWebhooks},
+		{"app", getAppWebhooks},
+	}
+
+	for _, scanner := range scanners {
+		if source != "" && source != scanner.source {
+			continue
+		}
+
+		webhooks, err := scanner.scan(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, webhook := range webhooks {
+			d.StreamListItem(ctx, webhook)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getAdminWebhooks returns the webhooks registered by administrators, which
+// are managed through the webhooks API of both Cloud and Data Center
+func getAdminWebhooks(ctx context.Context, d *plugin.QueryData) ([]Webhook, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_webhook.getAdminWebhooks", "connection_error", err)
+		return nil, err
+	}
+
+	req, err := client.NewRequest("GET", "rest/webhooks/1.0/webhook", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_webhook.getAdminWebhooks", "get_request_error", err)
+		return nil, err
+	}
+
+	adminWebhooks := []AdminWebhook{}
+	_, err = client.Do(req, &adminWebhooks)
+	if err != nil {
+		// Admin webhooks can only be read by Jira administrators
+		if isNotFoundError(err) || isForbiddenError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_webhook.getAdminWebhooks", "api_error", err)
+		return nil, err
+	}
+
+	webhooks := []Webhook{}
+	for _, w := range adminWebhooks {
+		webhook := Webhook{
+			Source:        "admin",
+			ID:            path.Base(w.Self),
+			Name:          w.Name,
+			URL:           w.URL,
+			Enabled:       w.Enabled,
+			JqlFilter:     w.Filters["issue-related-events-section"],
+			ExcludeBody:   w.ExcludeBody,
+			LastUpdatedBy: w.LastUpdatedDisplayName,
+			Events:        w.Events,
+			Self:          w.Self,
+		}
+		if w.LastUpdated != 0 {
+			webhook.LastUpdated = time.UnixMilli(w.LastUpdated)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+// getAppWebhooks returns the webhooks registered by the app making the
+// request. Requests authenticated as a user are rejected by Jira.
+func getAppWebhooks(ctx context.Context, d *plugin.QueryData) ([]Webhook, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_webhook.getAppWebhooks", "connection_error", err)
+		return nil, err
+	}
+
+	webhooks := []Webhook{}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/webhook?startAt=%d&maxResults=100", last)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_webhook.getAppWebhooks", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListAppWebhookResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			if isNotFoundError(err) || isBadRequestError(err) || isForbiddenError(err) {
+				return webhooks, nil
+			}
+			plugin.Logger(ctx).Error("jira_webhook.getAppWebhooks", "api_error", err)
+			return nil, err
+		}
+
+		for _, w := range listResult.Values {
+			webhook := Webhook{
+				Source:    "app",
+				ID:        fmt.Sprint(w.ID),
+				Enabled:   true,
+				JqlFilter: w.JqlFilter,
+				Events:    w.Events,
+			}
+			if w.ExpirationDate != 0 {
+				webhook.ExpirationDate = time.UnixMilli(w.ExpirationDate)
+			}
+			webhooks = append(webhooks, webhook)
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return webhooks, nil
+		}
+	}
+}
+
+//// TRANSFORM FUNCTION
+
+func webhookTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	webhook := d.HydrateItem.(Webhook)
+	if webhook.Name != "" {
+		return webhook.Name, nil
+	}
+	return webhook.ID, nil
+}
+
+//// Custom Structs
+
+type Webhook struct {
+	Source         string
+	ID             string
+	Name           string
+	URL            string
+	Enabled        bool
+	JqlFilter      string
+	ExcludeBody    bool
+	LastUpdated    time.Time
+	LastUpdatedBy  string
+	ExpirationDate time.Time
+	Events         []string
+	Self           string
+}
+
+type AdminWebhook struct {
+	Self                   string            `json:"self"`
+	Name                   string            `json:"name"`
+	URL                    string            `json:"url"`
+	Enabled                bool              `json:"enabled"`
+	ExcludeBody            bool              `json:"excludeBody"`
+	Filters                map[string]string `json:"filters"`
+	Events                 []string          `json:"events"`
+	LastUpdated            int64             `json:"lastUpdated"`
+	LastUpdatedDisplayName string            `json:"lastUpdatedDisplayName"`
+}
+
+type ListAppWebhookResult struct {
+	MaxResults int          `json:"maxResults"`
+	StartAt    int          `json:"startAt"`
+	Total      int          `json:"total"`
+	IsLast     bool         `json:"isLast"`
+	Values     []AppWebhook `json:"values"`
+}
+
+type AppWebhook struct {
+	ID             int64    `json:"id"`
+	JqlFilter      string   `json:"jqlFilter"`
+	Events         []string `json:"events"`
+	ExpirationDate int64    `json:"expirationDate"`
+}
@@ -0,0 +1,273 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableWorkflowLint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_workflow_lint",
+		Description: "Structural problems found in workflows, such as unreachable statuses and dead ends, with one row per finding.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listWorkflows,
+			Hydrate:       listWorkflowLintFindings,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "workflow_name", Require: plugin.Optional},
+				{Name: "rule", Require: plugin.Optional},
+				{Name: "severity", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "workflow_name",
+				Description: "The name of the workflow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule",
+				Description: "The rule that produced the finding. Possible values are unreachable_status, dead_end_status, done_status_without_resolution_clear, duplicate_transition_name and unused_status.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the finding. Possible values are error, warning and info.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_id",
+				Description: "The ID of the status the finding applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StatusId").NullIfZero(),
+			},
+			{
+				Name:        "status_name",
+				Description: "The name of the status the finding applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StatusName").NullIfZero(),
+			},
+			{
+				Name:        "transition_id",
+				Description: "The ID of the transition the finding applies to, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TransitionId").NullIfZero(),
+			},
+			{
+				Name:        "transition_name",
+				Description: "The name of the transition the finding applies to, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TransitionName").NullIfZero(),
+			},
+			{
+				Name:        "description",
+				Description: "A description of the problem.",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listWorkflowLintFindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workflow := h.Item.(Workflow)
+
+	if d.EqualsQualString("workflow_name") != "" && d.EqualsQualString("workflow_name") != workflow.ID.Name {
+		return nil, nil
+	}
+
+	categories, err := getStatusCategories(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := d.EqualsQualString("rule")
+	severity := d.EqualsQualString("severity")
+	for _, finding := range lintWorkflow(workflow, categories) {
+		if rule != "" && finding.Rule != rule {
+			continue
+		}
+		if severity != "" && finding.Severity != severity {
+			continue
+		}
+
+		d.StreamListItem(ctx, finding)
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// lintWorkflow returns the structural problems of a workflow. The categories
+// map the global status IDs to their status category, TODO, IN_PROGRESS or
+// DONE. It makes no API calls, so it can be run against fixture workflows.
+func lintWorkflow(workflow Workflow, categories map[string]string) []WorkflowLintFinding {
+	findings := []WorkflowLintFinding{}
+
+	newFinding := func(rule string, severity string, status WorkflowStatus, transition *WorkflowTransition, description string) WorkflowLintFinding {
+		finding := WorkflowLintFinding{
+			WorkflowName: workflow.ID.Name,
+			Rule:         rule,
+			Severity:     severity,
+			StatusId:     status.ID,
+			StatusName:   status.Name,
+			Description:  description,
+		}
+		if transition != nil {
+			finding.TransitionId = transition.ID
+			finding.TransitionName = transition.Name
+		}
+		return finding
+	}
+
+	// Statuses that are not the source or target of any transition are
+	// reported as unused and left out of the other rules
+	used := map[string]bool{}
+	for _, transition := range workflow.Transitions {
+		used[transition.To] = true
+		for _, from := range transition.From {
+			used[from] = true
+		}
+	}
+
+	reachable := workflowReachableStatuses(workflow)
+
+	for _, status := range workflow.Statuses {
+		if !used[status.ID] {
+			findings = append(findings, newFinding("unused_status", "info", status, nil,
+				fmt.Sprintf("Status '%s' is not used by any transition.", status.Name)))
+			continue
+		}
+
+		if !reachable[status.ID] {
+			findings = append(findings, newFinding("unreachable_status", "error", status, nil,
+				fmt.Sprintf("Status '%s' cannot be reached from the initial status.", status.Name)))
+		}
+
+		outgoing := workflowOutgoingTransitions(workflow, status.ID)
+		isDone := categories[status.ID] == "DONE"
+
+		if len(outgoing) == 0 && !isDone {
+			findings = append(findings, newFinding("dead_end_status", "error", status, nil,
+				fmt.Sprintf("Status '%s' has no outgoing transitions and is not in the Done category, so issues in it can never be completed.", status.Name)))
+		}
+
+		// Issues moved out of a done status keep their resolution, and are
+		// still treated as resolved, unless the transition clears it
+		if isDone {
+			for i, transition := range outgoing {
+				if categories[transition.To] == "DONE" || hasResolutionPostFunction(transition) {
+					continue
+				}
+				findings = append(findings, newFinding("done_status_without_resolution_clear", "warning", status, &outgoing[i],
+					fmt.Sprintf("Transition '%s' moves issues out of done status '%s' without a post function that updates the resolution.", transition.Name, status.Name)))
+			}
+		}
+
+		seen := map[string]int{}
+		for i, transition := range outgoing {
+			name := strings.ToLower(strings.TrimSpace(transition.Name))
+			seen[name]++
+			if seen[name] == 2 {
+				findings = append(findings, newFinding("duplicate_transition_name", "warning", status, &outgoing[i],
+					fmt.Sprintf("More than one transition named '%s' is available from status '%s'.", transition.Name, status.Name)))
+			}
+		}
+	}
+
+	return findings
+}
+
+// workflowReachableStatuses returns the statuses that can be reached from
+// the target of the initial transition of a workflow
+func workflowReachableStatuses(workflow Workflow) map[string]bool {
+	reachable := map[string]bool{}
+
+	queue := []string{}
+	for _, transition := range workflow.Transitions {
+		if transition.Type == "initial" {
+			queue = append(queue, transition.To)
+		}
+	}
+
+	for len(queue) > 0 {
+		statusId := queue[0]
+		queue = queue[1:]
+		if reachable[statusId] {
+			continue
+		}
+		reachable[statusId] = true
+
+		for _, transition := range workflowOutgoingTransitions(workflow, statusId) {
+			if !reachable[transition.To] {
+				queue = append(queue, transition.To)
+			}
+		}
+	}
+
+	return reachable
+}
+
+// workflowOutgoingTransitions returns the transitions that are available
+// from a status, including the global transitions to other statuses
+func workflowOutgoingTransitions(workflow Workflow, statusId string) []WorkflowTransition {
+	outgoing := []WorkflowTransition{}
+	for _, transition := range workflow.Transitions {
+		if transition.Type == "initial" {
+			continue
+		}
+		if len(transition.From) == 0 {
+			if transition.To != statusId {
+				outgoing = append(outgoing, transition)
+			}
+			continue
+		}
+		for _, from := range transition.From {
+			if from == statusId {
+				outgoing = append(outgoing, transition)
+				break
+			}
+		}
+	}
+	return outgoing
+}
+
+// hasResolutionPostFunction reports whether a transition has a post function
+// that updates the resolution field of the issue
+func hasResolutionPostFunction(transition WorkflowTransition) bool {
+	for _, rule := range flattenWorkflowTransitionRules(transition.Rules) {
+		if rule.RuleType != "post_function" || rule.RuleKey != "UpdateIssueFieldFunction" {
+			continue
+		}
+		if configuration, ok := rule.Configuration.(map[string]interface{}); ok && configuration["fieldId"] == "resolution" {
+			return true
+		}
+	}
+	return false
+}
+
+//// Custom Structs
+
+type WorkflowLintFinding struct {
+	WorkflowName   string
+	Rule           string
+	Severity       string
+	StatusId       string
+	StatusName     string
+	TransitionId   string
+	TransitionName string
+	Description    string
+}
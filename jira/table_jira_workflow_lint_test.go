@@ -0,0 +1,117 @@
+package jira
+
+import (
+	"reflect"
+	"testing"
+)
+
+func clearResolutionPostFunction() WorkflowTransitionRules {
+	return WorkflowTransitionRules{
+		Type:          "UpdateIssueFieldFunction",
+		Configuration: map[string]interface{}{"fieldId": "resolution", "fieldValue": ""},
+	}
+}
+
+func TestLintWorkflow(t *testing.T) {
+	workflow := Workflow{
+		ID: WorkflowID{Name: "Software Simplified Workflow"},
+		Statuses: []WorkflowStatus{
+			{ID: "1", Name: "To Do"},
+			{ID: "2", Name: "In Progress"},
+			{ID: "3", Name: "Done"},
+			{ID: "4", Name: "Blocked"},
+			{ID: "5", Name: "Archived"},
+			{ID: "6", Name: "Legacy"},
+		},
+		Transitions: []WorkflowTransition{
+			{ID: "1", Name: "Create", To: "1", Type: "initial"},
+			{ID: "11", Name: "Start", From: []string{"1"}, To: "2", Type: "directed"},
+			{ID: "21", Name: "Finish", From: []string{"2"}, To: "3", Type: "directed"},
+			{
+				ID: "31", Name: "Reopen", From: []string{"3"}, To: "1", Type: "directed",
+				Rules: WorkflowRules{PostFunctions: []WorkflowTransitionRules{clearResolutionPostFunction()}},
+			},
+			{ID: "41", Name: "Back to progress", From: []string{"3"}, To: "2", Type: "directed"},
+			// Global transition, available from every other status
+			{ID: "51", Name: "Block", To: "4", Type: "global"},
+			{ID: "61", Name: "start ", From: []string{"1"}, To: "3", Type: "directed"},
+			{ID: "71", Name: "Restore", From: []string{"5"}, To: "2", Type: "directed"},
+		},
+	}
+
+	// Blocked is left out on purpose, as statuses can be missing from the
+	// status categories
+	categories := map[string]string{
+		"1": "NEW",
+		"2": "INDETERMINATE",
+		"3": "DONE",
+		"5": "INDETERMINATE",
+		"6": "NEW",
+	}
+
+	type result struct {
+		rule         string
+		severity     string
+		statusId     string
+		transitionId string
+	}
+
+	want := []result{
+		{"duplicate_transition_name", "warning", "1", "61"},
+		{"done_status_without_resolution_clear", "warning", "3", "41"},
+		{"done_status_without_resolution_clear", "warning", "3", "51"},
+		{"dead_end_status", "error", "4", ""},
+		{"unreachable_status", "error", "5", ""},
+		{"unused_status", "info", "6", ""},
+	}
+
+	got := []result{}
+	for _, finding := range lintWorkflow(workflow, categories) {
+		if finding.WorkflowName != workflow.ID.Name {
+			t.Errorf("finding %s has workflow name %q, want %q", finding.Rule, finding.WorkflowName, workflow.ID.Name)
+		}
+		got = append(got, result{finding.Rule, finding.Severity, finding.StatusId, finding.TransitionId})
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("lintWorkflow() = %+v, want %+v", got, want)
+	}
+}
+
+func TestWorkflowReachableStatuses(t *testing.T) {
+	workflow := Workflow{
+		Transitions: []WorkflowTransition{
+			{ID: "1", Name: "Create", To: "1", Type: "initial"},
+			{ID: "11", Name: "Start", From: []string{"1"}, To: "2", Type: "directed"},
+			{ID: "21", Name: "Block", To: "3", Type: "global"},
+			{ID: "31", Name: "Restore", From: []string{"4"}, To: "2", Type: "directed"},
+		},
+	}
+
+	want := map[string]bool{"1": true, "2": true, "3": true}
+	if got := workflowReachableStatuses(workflow); !reflect.DeepEqual(got, want) {
+		t.Errorf("workflowReachableStatuses() = %v, want %v", got, want)
+	}
+}
+
+func TestHasResolutionPostFunction(t *testing.T) {
+	cases := []struct {
+		name          string
+		postFunctions []WorkflowTransitionRules
+		want          bool
+	}{
+		{name: "clears resolution", postFunctions: []WorkflowTransitionRules{clearResolutionPostFunction()}, want: true},
+		{name: "updates another field", postFunctions: []WorkflowTransitionRules{{Type: "UpdateIssueFieldFunction", Configuration: map[string]interface{}{"fieldId": "assignee"}}}},
+		{name: "other post function", postFunctions: []WorkflowTransitionRules{{Type: "AssignToCurrentUserFunction"}}},
+		{name: "no post functions"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			transition := WorkflowTransition{Rules: WorkflowRules{PostFunctions: c.postFunctions}}
+			if got := hasResolutionPostFunction(transition); got != c.want {
+				t.Errorf("hasResolutionPostFunction() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
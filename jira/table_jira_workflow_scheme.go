@@ -0,0 +1,183 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableWorkflowScheme(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_workflow_scheme",
+		Description: "Workflow schemes define which workflow is used for each issue type in the projects they are associated with.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getWorkflowScheme,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listWorkflowSchemes,
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the workflow scheme.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the workflow scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the workflow scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "default_workflow",
+				Description: "The name of the default workflow, used for issue types without a workflow mapping.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "draft",
+				Description: "Whether the workflow scheme is a draft.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "self",
+				Description: "The URL of the workflow scheme.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+			{
+				Name:        "issue_type_mappings",
+				Description: "The issue type to workflow mappings, where each key is an issue type ID and each value is a workflow name.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listWorkflowSchemes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_workflow_scheme.listWorkflowSchemes", "connection_error", err)
+		return nil, err
+	}
+
+	// If the requested number of items is less than the paging max limit
+	// set the limit to that instead
+	queryLimit := d.QueryContext.Limit
+	var maxResults int = 50
+	if d.QueryContext.Limit != nil {
+		if *queryLimit < 50 {
+			maxResults = int(*queryLimit)
+		}
+	}
+
+	last := 0
+	for {
+		apiEndpoint := fmt.Sprintf("rest/api/3/workflowscheme?startAt=%d&maxResults=%d", last, maxResults)
+
+		req, err := client.NewRequest("GET", apiEndpoint, nil)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_workflow_scheme.listWorkflowSchemes", "get_request_error", err)
+			return nil, err
+		}
+
+		listResult := new(ListWorkflowSchemeResult)
+		_, err = client.Do(req, listResult)
+		if err != nil {
+			plugin.Logger(ctx).Error("jira_workflow_scheme.listWorkflowSchemes", "api_error", err)
+			return nil, err
+		}
+
+		for _, scheme := range listResult.Values {
+			d.StreamListItem(ctx, scheme)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		last = listResult.StartAt + len(listResult.Values)
+		if listResult.IsLast || len(listResult.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func getWorkflowScheme(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	schemeId := d.EqualsQuals["id"].GetInt64Value()
+	if schemeId == 0 {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_workflow_scheme.getWorkflowScheme", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/workflowscheme/%d", schemeId)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_workflow_scheme.getWorkflowScheme", "get_request_error", err)
+		return nil, err
+	}
+
+	scheme := new(WorkflowScheme)
+	_, err = client.Do(req, scheme)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_workflow_scheme.getWorkflowScheme", "api_error", err)
+		return nil, err
+	}
+
+	return *scheme, nil
+}
+
+//// Custom Structs
+
+type ListWorkflowSchemeResult struct {
+	Self       string           `json:"self"`
+	NextPage   string           `json:"nextPage"`
+	MaxResults int              `json:"maxResults"`
+	StartAt    int              `json:"startAt"`
+	Total      int              `json:"total"`
+	IsLast     bool             `json:"isLast"`
+	Values     []WorkflowScheme `json:"values"`
+}
+
+type WorkflowScheme struct {
+	ID                int64             `json:"id"`
+	Self              string            `json:"self"`
+	Name              string            `json:"name"`
+	Description       string            `json:"description"`
+	DefaultWorkflow   string            `json:"defaultWorkflow"`
+	Draft             bool              `json:"draft"`
+	IssueTypeMappings map[string]string `json:"issueTypeMappings"`
+}
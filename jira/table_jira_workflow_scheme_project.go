@@ -0,0 +1,114 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableWorkflowSchemeProject(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_workflow_scheme_project",
+		Description: "The workflow scheme associated with each project.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listProjects,
+			Hydrate:       listWorkflowSchemeProjects,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "project_id", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "project_id",
+				Description: "The ID of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_key",
+				Description: "The key of the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "workflow_scheme_id",
+				Description: "The ID of the workflow scheme associated with the project.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "workflow_scheme_name",
+				Description: "The name of the workflow scheme associated with the project.",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listWorkflowSchemeProjects(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	project := h.Item.(Project)
+
+	if d.EqualsQualString("project_id") != "" && d.EqualsQualString("project_id") != project.ID {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_workflow_scheme_project.listWorkflowSchemeProjects", "connection_error", err)
+		return nil, err
+	}
+
+	apiEndpoint := fmt.Sprintf("rest/api/3/workflowscheme/project?projectId=%s", project.ID)
+
+	req, err := client.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("jira_workflow_scheme_project.listWorkflowSchemeProjects", "get_request_error", err)
+		return nil, err
+	}
+
+	listResult := new(ListWorkflowSchemeProjectResult)
+	_, err = client.Do(req, listResult)
+	if err != nil {
+		// Team-managed projects do not use workflow schemes
+		if isNotFoundError(err) || isBadRequestError(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("jira_workflow_scheme_project.listWorkflowSchemeProjects", "api_error", err)
+		return nil, err
+	}
+
+	for _, association := range listResult.Values {
+		d.StreamListItem(ctx, WorkflowSchemeProject{
+			ProjectId:          project.ID,
+			ProjectKey:         project.Key,
+			WorkflowSchemeId:   association.WorkflowScheme.ID,
+			WorkflowSchemeName: association.WorkflowScheme.Name,
+		})
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type ListWorkflowSchemeProjectResult struct {
+	Values []struct {
+		ProjectIds     []string       `json:"projectIds"`
+		WorkflowScheme WorkflowScheme `json:"workflowScheme"`
+	} `json:"values"`
+}
+
+type WorkflowSchemeProject struct {
+	ProjectId          string
+	ProjectKey         string
+	WorkflowSchemeId   int64
+	WorkflowSchemeName string
+}
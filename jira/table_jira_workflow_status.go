@@ -0,0 +1,95 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableWorkflowStatus(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_workflow_status",
+		Description: "The statuses used by each workflow, with their global status IDs and categories.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listWorkflows,
+			Hydrate:       listWorkflowStatuses,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "workflow_name", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "workflow_name",
+				Description: "The name of the workflow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_id",
+				Description: "The ID of the status, which joins to the jira_status table.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the status in the workflow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status_category",
+				Description: "The category of the status. Possible values are TODO, IN_PROGRESS and DONE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StatusCategory").NullIfZero(),
+			},
+			{
+				Name:        "status_category_key",
+				Description: "The key of the status category, which joins to the jira_status_category table. Possible values are new, indeterminate and done.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StatusCategory").Transform(statusCategoryToKey),
+			},
+			{
+				Name:        "issue_editable",
+				Description: "Whether issues are editable in this status.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IssueEditable"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listWorkflowStatuses(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workflow := h.Item.(Workflow)
+
+	if d.EqualsQualString("workflow_name") != "" && d.EqualsQualString("workflow_name") != workflow.ID.Name {
+		return nil, nil
+	}
+
+	categories, err := getStatusCategories(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, status := range workflow.Statuses {
+		d.StreamListItem(ctx, WorkflowStatusInfo{status, workflow.ID.Name, categories[status.ID]})
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type WorkflowStatusInfo struct {
+	WorkflowStatus
+	WorkflowName   string
+	StatusCategory string
+}
@@ -0,0 +1,101 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableWorkflowTransition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_workflow_transition",
+		Description: "The transitions of each workflow, which move issues from one status to another.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listWorkflows,
+			Hydrate:       listWorkflowTransitions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "workflow_name", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "workflow_name",
+				Description: "The name of the workflow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the transition, unique within the workflow.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the transition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the transition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the transition. Possible values are initial, directed and global.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "to_status_id",
+				Description: "The ID of the status the transition moves issues to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("To"),
+			},
+			{
+				Name:        "screen_id",
+				Description: "The ID of the screen displayed during the transition, if any.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Screen.ID").Transform(convertStringToInt),
+			},
+
+			// JSON fields
+			{
+				Name:        "from_status_ids",
+				Description: "The IDs of the statuses the transition can be performed from. Empty for initial and global transitions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("From"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listWorkflowTransitions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workflow := h.Item.(Workflow)
+
+	if d.EqualsQualString("workflow_name") != "" && d.EqualsQualString("workflow_name") != workflow.ID.Name {
+		return nil, nil
+	}
+
+	for _, transition := range workflow.Transitions {
+		d.StreamListItem(ctx, WorkflowTransitionInfo{transition, workflow.ID.Name})
+		// Context may get cancelled due to manual cancellation or if the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// Custom Structs
+
+type WorkflowTransitionInfo struct {
+	WorkflowTransition
+	WorkflowName string
+}
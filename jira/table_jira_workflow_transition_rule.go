@@ -0,0 +1,149 @@
+package jira
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableWorkflowTransitionRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "jira_workflow_transition_rule",
+		Description: "The conditions, validators and post functions of each workflow transition.",
+		List: &plugin.ListConfig{
+			ParentHydrate: listWorkflows,
+			Hydrate:       listWorkflowTransitionRules,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "workflow_name", Require: plugin.Optional},
+				{Name: "rule_type", Require: plugin.Optional},
+			},
+		},
+		Columns: commonColumns([]*plugin.Column{
+			{
+				Name:        "workflow_name",
+				Description: "The name of the workflow.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transition_id",
+				Description: "The ID of the transition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "transition_name",
+				Description: "The name of the transition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule_type",
+				Description: "The type of the rule. Possible values are condition, validator and post_function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule_key",
+				Description: "The key of the rule, for example PermissionCondition, FieldRequiredValidator or UpdateIssueFieldFunction.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// JSON fields
+			{
+				Name:        "configuration",
+				Description: "The configuration of the rule.",
+				Type:        proto.ColumnType_JSON,
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listWorkflowTransitionRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	workflow := h.Item.(Workflow)
+
+	if d.EqualsQualString("workflow_name") != "" && d.EqualsQualString("workflow_name") != workflow.ID.Name {
+		return nil, nil
+	}
+
+	ruleType := d.EqualsQualString("rule_type")
+	for _, transition := range workflow.Transitions {
+		for _, rule := range flattenWorkflowTransitionRules(transition.Rules) {
+			if ruleType != "" && rule.RuleType != ruleType {
+				continue
+			}
+
+			rule.WorkflowName = workflow.ID.Name
+			rule.TransitionId = transition.ID
+			rule.TransitionName = transition.Name
+
+			d.StreamListItem(ctx, rule)
+			// Context may get cancelled due to manual cancellation or if the limit has been reached
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// flattenWorkflowTransitionRules returns the conditions, validators and post
+// functions of a transition as a single list. Conditions are nested in a tree
+// of compound AND/OR nodes, of which only the simple conditions are returned.
+func flattenWorkflowTransitionRules(rules WorkflowRules) []WorkflowTransitionRule {
+	flattened := []WorkflowTransitionRule{}
+
+	var walk func(node interface{})
+	walk = func(node interface{}) {
+		item, ok := node.(map[string]interface{})
+		if !ok {
+			return
+		}
+		if conditions, ok := item["conditions"].([]interface{}); ok {
+			for _, condition := range conditions {
+				walk(condition)
+			}
+		}
+		if ruleKey, ok := item["type"].(string); ok && ruleKey != "" {
+			flattened = append(flattened, WorkflowTransitionRule{
+				RuleType:      "condition",
+				RuleKey:       ruleKey,
+				Configuration: item["configuration"],
+			})
+		}
+	}
+	walk(rules.ConditionsTree)
+
+	for _, validator := range rules.Validators {
+		flattened = append(flattened, WorkflowTransitionRule{
+			RuleType:      "validator",
+			RuleKey:       validator.Type,
+			Configuration: validator.Configuration,
+		})
+	}
+	for _, postFunction := range rules.PostFunctions {
+		flattened = append(flattened, WorkflowTransitionRule{
+			RuleType:      "post_function",
+			RuleKey:       postFunction.Type,
+			Configuration: postFunction.Configuration,
+		})
+	}
+
+	return flattened
+}
+
+//// Custom Structs
+
+type WorkflowTransitionRule struct {
+	WorkflowName   string
+	TransitionId   string
+	TransitionName string
+	RuleType       string
+	RuleKey        string
+	Configuration  interface{}
+}
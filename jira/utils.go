@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -84,6 +85,35 @@ func connect(_ context.Context, d *plugin.QueryData) (*jira.Client, error) {
 	return client, nil
 }
 
+// isJiraCloud reports whether the connection points at Jira Cloud, as
+// opposed to a Jira Server or Data Center instance
+func isJiraCloud(ctx context.Context, d *plugin.QueryData) (bool, error) {
+	deploymentType, err := getDeploymentType(ctx, d)
+	if err != nil {
+		return false, err
+	}
+	return deploymentType == "Cloud", nil
+}
+
+// getDeploymentType returns the deployment type of the Jira instance, one of
+// Cloud, Server and DataCenter. Only the deployment type is cached, as the
+// rest of the server information changes over time.
+func getDeploymentType(ctx context.Context, d *plugin.QueryData) (string, error) {
+	cacheKey := "deployment_type"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(string), nil
+	}
+
+	serverInfo, err := getServerInfo(ctx, d)
+	if err != nil {
+		return "", err
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, serverInfo.DeploymentType)
+
+	return serverInfo.DeploymentType, nil
+}
+
 // // Constants
 const (
 	ColumnDescriptionTitle = "Title of the resource."
@@ -136,6 +166,24 @@ func convertJiraDate(_ context.Context, d *transform.TransformData) (interface{}
 	return time.Time(d.Value.(jira.Date)), nil
 }
 
+// quoteJQLValue quotes a value for use in a JQL query, escaping the
+// characters that would end the string
+func quoteJQLValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return fmt.Sprintf(`"%s"`, value)
+}
+
+// convertStringToInt parses an ID that the API returns as a string, so it
+// can be joined with the INT id columns of other tables
+func convertStringToInt(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	id, ok := d.Value.(string)
+	if !ok || id == "" {
+		return nil, nil
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
+
 func buildJQLQueryFromQuals(equalQuals plugin.KeyColumnQualMap, tableColumns []*plugin.Column) string {
 	filters := []string{}
 	for _, filterQualItem := range tableColumns {
@@ -151,6 +199,14 @@ func buildJQLQueryFromQuals(equalQuals plugin.KeyColumnQualMap, tableColumns []*
 			}
 
 			for _, qual := range filterQual.Quals {
+				switch qual.Operator {
+				case "is null":
+					filters = append(filters, fmt.Sprintf("\"%s\" is EMPTY", getIssueJQLKey(filterQualItem.Name)))
+					continue
+				case "is not null":
+					filters = append(filters, fmt.Sprintf("\"%s\" is not EMPTY", getIssueJQLKey(filterQualItem.Name)))
+					continue
+				}
 				if qual.Value != nil {
 					value := qual.Value
 					switch filterQualItem.Type {
@@ -199,6 +255,7 @@ func getIssueJQLKey(columnName string) string {
 		"key":             "key",
 		"resolution_date": "resolutiondate",
 		"status_category": "statuscategory",
+		"status_id":       "status",
 	}
 
 	if val, ok := remappedColumns[columnName]; ok {